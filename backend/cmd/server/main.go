@@ -48,7 +48,11 @@ func main() {
 	leagueRepo := factory.NewLeagueRepository()
 	tournamentRepo := factory.NewTournamentRepository()
 	matchRepo := factory.NewMatchRepository()
+	gameRepo := factory.NewGameRepository()
+	throwRepo := factory.NewThrowRepository()
+	scoringEventRepo := factory.NewScoringEventRepository()
 	standingsRepo := factory.NewLeagueStandingsRepository()
+	statsRepo := factory.NewStatisticsRepository()
 
 	// Initialize use cases
 	useCases := usecases.NewUseCases(
@@ -56,7 +60,12 @@ func main() {
 		leagueRepo,
 		tournamentRepo,
 		matchRepo,
+		gameRepo,
+		throwRepo,
+		scoringEventRepo,
 		standingsRepo,
+		statsRepo,
+		factory,
 	)
 
 	log.Println("✅ Use cases initialized")
@@ -14,6 +14,7 @@ type UpdatePlayerRequest struct {
 	Email     *string `json:"email,omitempty" binding:"omitempty,email"`
 	Nickname  *string `json:"nickname,omitempty" binding:"omitempty,max=50"`
 	AvatarURL *string `json:"avatar_url,omitempty" binding:"omitempty,url"`
+	Rating    *int    `json:"rating,omitempty" binding:"omitempty,min=0"`
 }
 
 // League DTOs
@@ -21,6 +22,23 @@ type CreateLeagueRequest struct {
 	Name        string `json:"name" binding:"required,min=1,max=255"`
 	Description string `json:"description,omitempty"`
 	Season      string `json:"season,omitempty" binding:"omitempty,max=100"`
+
+	// Points system
+	PointsSchedule      map[string]int `json:"points_schedule,omitempty" binding:"omitempty,dive,min=0"`
+	ParticipationPoints *int           `json:"participation_points,omitempty" binding:"omitempty,min=0"`
+	PointsPerMatchWin   *int           `json:"points_per_match_win,omitempty" binding:"omitempty,min=0"`
+
+	// Standings order after league points
+	StandingsTiebreakers []string `json:"standings_tiebreakers,omitempty" binding:"omitempty,dive,oneof=tournaments_won finals_reached semi_finals_reached head_to_head league_average"`
+}
+
+type UpdateLeaguePointsRequest struct {
+	PointsSchedule      map[string]int `json:"points_schedule,omitempty" binding:"omitempty,dive,min=0"`
+	ParticipationPoints *int           `json:"participation_points,omitempty" binding:"omitempty,min=0"`
+	PointsPerMatchWin   *int           `json:"points_per_match_win,omitempty" binding:"omitempty,min=0"`
+
+	// Standings order after league points
+	StandingsTiebreakers []string `json:"standings_tiebreakers,omitempty" binding:"omitempty,dive,oneof=tournaments_won finals_reached semi_finals_reached head_to_head league_average"`
 }
 
 type AddPlayerToLeagueRequest struct {
@@ -31,11 +49,64 @@ type AddPlayerToLeagueRequest struct {
 type CreateTournamentRequest struct {
 	LeagueID    uuid.UUID `json:"league_id" binding:"required"`
 	Name        string    `json:"name" binding:"required,min=1,max=255"`
-	Type        string    `json:"type" binding:"required,oneof=single_elimination double_elimination round_robin"`
+	Type        string    `json:"type" binding:"required,oneof=single_elimination double_elimination round_robin group_knockout swiss"`
+
+	TournamentSettingsRequest
+}
+
+// UpdateTournamentRequest changes a tournament before it starts; omitted
+// fields keep their current values
+type UpdateTournamentRequest struct {
+	Name *string `json:"name,omitempty" binding:"omitempty,min=1,max=255"`
+
+	TournamentSettingsRequest
+}
+
+// TournamentSettingsRequest holds the optional settings shared by the create
+// and update requests
+type TournamentSettingsRequest struct {
+	// Format settings
+	GrandFinalReset *bool    `json:"grand_final_reset,omitempty"`
+	Tiebreakers     []string `json:"tiebreakers,omitempty" binding:"omitempty,dive,oneof=leg_difference legs_won head_to_head matches_won buchholz sonneborn_berger"`
+
+	// Group stage settings
+	GroupCount         *int `json:"group_count,omitempty" binding:"omitempty,min=0"`
+	QualifiersPerGroup *int `json:"qualifiers_per_group,omitempty" binding:"omitempty,min=1"`
+
+	// Swiss settings (0 = derived from the field size)
+	SwissRounds *int `json:"swiss_rounds,omitempty" binding:"omitempty,min=0"`
+
+	// Match length
+	MatchFormat  string `json:"match_format,omitempty" binding:"omitempty,oneof=best_of fixed"`
+	LegsPerMatch *int   `json:"legs_per_match,omitempty" binding:"omitempty,min=1"`
+	SetsPerMatch *int   `json:"sets_per_match,omitempty" binding:"omitempty,min=1"`
+
+	// Deciding set (or, without sets, the match) must be won by two clear legs
+	FinalSetTiebreak *bool `json:"final_set_tiebreak,omitempty"`
+
+	// Game played in the legs (default 501)
+	GameType string `json:"game_type,omitempty" binding:"omitempty,oneof=501 301 cricket cricket_cut_throat around_the_clock shanghai killer bobs_27"`
+
+	// X01 rules (default straight in, double out from the game's usual score)
+	StartScore *int   `json:"start_score,omitempty" binding:"omitempty,min=2"`
+	InRule     string `json:"in_rule,omitempty" binding:"omitempty,oneof=straight double master"`
+	OutRule    string `json:"out_rule,omitempty" binding:"omitempty,oneof=straight double master"`
 }
 
 type AddPlayerToTournamentRequest struct {
 	PlayerID uuid.UUID `json:"player_id" binding:"required"`
+	Seed     *int      `json:"seed,omitempty" binding:"omitempty,min=1"`
+}
+
+type StartTournamentRequest struct {
+	SeedingMethod string       `json:"seeding_method,omitempty" binding:"omitempty,oneof=registration league_position rating manual random"`
+	Seeds         []ManualSeed `json:"seeds,omitempty" binding:"omitempty,dive"`
+	RandomSeed    *int64       `json:"random_seed,omitempty"`
+}
+
+type ManualSeed struct {
+	PlayerID uuid.UUID `json:"player_id" binding:"required"`
+	Seed     int       `json:"seed" binding:"required,min=1"`
 }
 
 // Match DTOs
@@ -45,6 +116,9 @@ type CreateMatchRequest struct {
 	Player2ID    *uuid.UUID `json:"player2_id,omitempty"`
 	Round        int        `json:"round,omitempty" binding:"min=0"`
 	MatchNumber  int        `json:"match_number,omitempty" binding:"min=0"`
+
+	// Standalone matches only; tournament matches play the tournament's game
+	GameType string `json:"game_type,omitempty" binding:"omitempty,oneof=501 301 cricket cricket_cut_throat around_the_clock shanghai killer bobs_27"`
 }
 
 type StartMatchRequest struct {
@@ -61,6 +135,115 @@ type CompleteMatchRequest struct {
 	WinnerID uuid.UUID `json:"winner_id" binding:"required"`
 }
 
+type AwardMatchRequest struct {
+	WinnerID uuid.UUID `json:"winner_id" binding:"required"`
+	Outcome  string    `json:"outcome" binding:"required,oneof=walkover forfeit retired"`
+	Reason   string    `json:"reason,omitempty" binding:"max=500"`
+}
+
+// RecordThrowOffRequest records who won the bull-off or coin toss and so
+// throws first in the first leg
+type RecordThrowOffRequest struct {
+	Method   string    `json:"method" binding:"required,oneof=bull_off coin_toss"`
+	WinnerID uuid.UUID `json:"winner_id" binding:"required"`
+}
+
+// HandicapRequest is one player's head start: a lower start score for X01
+// legs (0 for the usual one) and legs already awarded
+type HandicapRequest struct {
+	StartScore  int `json:"start_score,omitempty" binding:"omitempty,min=2"`
+	LegsAwarded int `json:"legs_awarded,omitempty" binding:"omitempty,min=0"`
+}
+
+// SetHandicapsRequest sets both players' handicaps by hand
+type SetHandicapsRequest struct {
+	Player1 HandicapRequest `json:"player1"`
+	Player2 HandicapRequest `json:"player2"`
+}
+
+// HandicapBandRequest is a row of a handicap table
+type HandicapBandRequest struct {
+	MinAverage float64 `json:"min_average" binding:"min=0"`
+	Reduction  int     `json:"reduction" binding:"min=0"`
+	Legs       int     `json:"legs" binding:"min=0"`
+}
+
+// ComputeHandicapsRequest works out both players' handicaps from their league
+// averages, using the default handicap table unless one is given
+type ComputeHandicapsRequest struct {
+	Method string                `json:"method" binding:"required,oneof=start_score legs"`
+	Table  []HandicapBandRequest `json:"table,omitempty" binding:"omitempty,dive"`
+}
+
+// Leg DTOs
+type StartLegRequest struct {
+	FirstThrowerID *uuid.UUID `json:"first_thrower_id,omitempty"`
+}
+
+type CompleteLegRequest struct {
+	WinnerID uuid.UUID `json:"winner_id" binding:"required"`
+}
+
+type ThrowDartsRequest struct {
+	Darts      []DartRequest `json:"darts" binding:"required,min=1,max=3,dive"`
+	RecordedBy *string       `json:"recorded_by,omitempty" binding:"omitempty,max=100"`
+}
+
+// UndoThrowsRequest removes the last dart, or the whole last visit, of a leg
+type UndoThrowsRequest struct {
+	Scope      string  `json:"scope" binding:"required,oneof=dart visit"`
+	RecordedBy string  `json:"recorded_by" binding:"required,min=1,max=100"`
+	Reason     *string `json:"reason,omitempty" binding:"omitempty,max=500"`
+}
+
+// ScoreVisitRequest scores an X01 visit from its three-dart total
+type ScoreVisitRequest struct {
+	VisitRequest
+	RecordedBy *string `json:"recorded_by,omitempty" binding:"omitempty,max=100"`
+}
+
+// VisitRequest is a visit total. Darts is how many darts a checkout took
+// (default 3); bust marks a visit that went bust whatever its total.
+type VisitRequest struct {
+	Total int  `json:"total" binding:"min=0,max=180"`
+	Darts int  `json:"darts,omitempty" binding:"omitempty,min=1,max=3"`
+	Bust  bool `json:"bust,omitempty"`
+}
+
+// EditVisitRequest replaces a past visit with either its darts or, in visit
+// mode, its total
+type EditVisitRequest struct {
+	Darts      []DartRequest `json:"darts,omitempty" binding:"omitempty,min=1,max=3,dive"`
+	Visit      *VisitRequest `json:"visit,omitempty"`
+	RecordedBy string        `json:"recorded_by" binding:"required,min=1,max=100"`
+	Reason     *string       `json:"reason,omitempty" binding:"omitempty,max=500"`
+}
+
+// DartRequest is where a dart landed; segment 0 is a miss and 25 the bull.
+// An omitted multiplier is a single.
+type DartRequest struct {
+	Segment    int `json:"segment" binding:"min=0,max=25"`
+	Multiplier int `json:"multiplier,omitempty" binding:"min=0,max=3"`
+}
+
+// CheckoutQuery narrows a checkout suggestion to the darts left in the visit
+// and the out-rule (default 3 darts, double out)
+type CheckoutQuery struct {
+	Darts   int    `form:"darts,default=3" binding:"min=1,max=3"`
+	OutRule string `form:"out_rule" binding:"omitempty,oneof=straight double master"`
+}
+
+// LeaderboardQuery picks the metric a league leaderboard ranks players by
+type LeaderboardQuery struct {
+	Metric string `form:"metric" binding:"required,oneof=average checkout 180s best_finish"`
+	Limit  int    `form:"limit,default=10" binding:"min=1,max=100"`
+}
+
+// HeadToHeadQuery optionally narrows a head-to-head to one league
+type HeadToHeadQuery struct {
+	League string `form:"league"`
+}
+
 // Common DTOs
 type PaginationQuery struct {
 	Page  int `form:"page,default=1" binding:"min=1"`
@@ -69,4 +252,4 @@ type PaginationQuery struct {
 
 func (p *PaginationQuery) GetOffset() int {
 	return (p.Page - 1) * p.Limit
-}
\ No newline at end of file
+}
@@ -0,0 +1,536 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"darts-league-backend/internal/delivery/http"
+	"darts-league-backend/internal/delivery/http/dto"
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/usecases"
+)
+
+type GameHandler struct {
+	useCases *usecases.UseCases
+}
+
+func NewGameHandler(useCases *usecases.UseCases) *GameHandler {
+	return &GameHandler{useCases: useCases}
+}
+
+// GetMatchLegs godoc
+// @Summary Get legs of a match
+// @Description Get every leg of a match in the order they were played
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Success 200 {object} http.Response
+// @Router /api/matches/{id}/legs [get]
+func (h *GameHandler) GetMatchLegs(c *gin.Context) {
+	idStr := c.Param("id")
+	matchID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid match ID")
+		return
+	}
+
+	legs, err := h.useCases.Game.GetMatchLegs(c.Request.Context(), matchID)
+	if err != nil {
+		if err == entities.ErrMatchNotFound {
+			http.NotFoundResponse(c, "Match not found")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to get legs")
+		return
+	}
+
+	http.SuccessResponse(c, legs)
+}
+
+// StartLeg godoc
+// @Summary Start a leg
+// @Description Start the next leg of an ongoing match; without a first thrower the throw alternates from the previous leg
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param request body dto.StartLegRequest false "Leg start data"
+// @Success 201 {object} http.Response
+// @Router /api/matches/{id}/legs [post]
+func (h *GameHandler) StartLeg(c *gin.Context) {
+	idStr := c.Param("id")
+	matchID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid match ID")
+		return
+	}
+
+	// The first thrower is optional; an empty body alternates the throw
+	var req dto.StartLegRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	leg, err := h.useCases.Game.StartLeg(c.Request.Context(), matchID, req.FirstThrowerID)
+	if err != nil {
+		if err == entities.ErrMatchNotFound {
+			http.NotFoundResponse(c, "Match not found")
+			return
+		}
+		if err == entities.ErrMatchNotInProgress {
+			http.BadRequestResponse(c, "Match is not in progress")
+			return
+		}
+		if err == entities.ErrLegInProgress {
+			http.BadRequestResponse(c, "The current leg has not been finished")
+			return
+		}
+		if err == entities.ErrPlayerNotInMatch {
+			http.BadRequestResponse(c, "First thrower must be one of the match participants")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to start leg")
+		return
+	}
+
+	http.CreatedResponse(c, leg)
+}
+
+// CompleteLeg godoc
+// @Summary Complete a leg
+// @Description Record the winner of a leg; the match score is updated from the legs and the match completes once it is decided
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param leg_id path string true "Leg ID"
+// @Param request body dto.CompleteLegRequest true "Leg completion data"
+// @Success 200 {object} http.Response
+// @Router /api/matches/{id}/legs/{leg_id}/complete [post]
+func (h *GameHandler) CompleteLeg(c *gin.Context) {
+	idStr := c.Param("id")
+	matchID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid match ID")
+		return
+	}
+
+	legID, err := uuid.Parse(c.Param("leg_id"))
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid leg ID")
+		return
+	}
+
+	var req dto.CompleteLegRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	result, err := h.useCases.Game.CompleteLeg(c.Request.Context(), matchID, legID, req.WinnerID)
+	if err != nil {
+		if err == entities.ErrMatchNotFound {
+			http.NotFoundResponse(c, "Match not found")
+			return
+		}
+		if err == entities.ErrLegNotFound {
+			http.NotFoundResponse(c, "Leg not found")
+			return
+		}
+		if err == entities.ErrMatchNotInProgress {
+			http.BadRequestResponse(c, "Match is not in progress")
+			return
+		}
+		if err == entities.ErrLegNotInProgress {
+			http.BadRequestResponse(c, "Leg has already been completed")
+			return
+		}
+		if err == entities.ErrInvalidWinner {
+			http.BadRequestResponse(c, "Invalid winner - must be one of the match participants")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to complete leg")
+		return
+	}
+
+	http.SuccessResponse(c, result)
+}
+
+// GetLegState godoc
+// @Summary Get leg state
+// @Description Get a leg's scores, cricket marks per target and, while it is in progress, who throws next and their suggested X01 checkout
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Leg ID"
+// @Success 200 {object} http.Response
+// @Router /api/legs/{id} [get]
+func (h *GameHandler) GetLegState(c *gin.Context) {
+	idStr := c.Param("id")
+	legID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid leg ID")
+		return
+	}
+
+	state, err := h.useCases.Game.GetLegState(c.Request.Context(), legID)
+	if err != nil {
+		if err == entities.ErrLegNotFound {
+			http.NotFoundResponse(c, "Leg not found")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to get leg")
+		return
+	}
+
+	http.SuccessResponse(c, state)
+}
+
+// GetCheckout godoc
+// @Summary Suggest a checkout
+// @Description Get the preferred route to finish a remaining score with the darts left in the visit; scores that cannot be finished are marked not possible
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param remaining path int true "Remaining score"
+// @Param darts query int false "Darts left in the visit (1-3, default 3)"
+// @Param out_rule query string false "Out-rule: straight, double or master (default double)"
+// @Success 200 {object} http.Response
+// @Router /api/checkouts/{remaining} [get]
+func (h *GameHandler) GetCheckout(c *gin.Context) {
+	remaining, err := strconv.Atoi(c.Param("remaining"))
+	if err != nil || remaining < 0 {
+		http.BadRequestResponse(c, "Invalid remaining score")
+		return
+	}
+
+	var query dto.CheckoutQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		http.BadRequestResponse(c, "Invalid query parameters")
+		return
+	}
+
+	checkout := h.useCases.Game.SuggestCheckout(remaining, query.Darts, entities.CheckRule(query.OutRule))
+	http.SuccessResponse(c, checkout)
+}
+
+// GetLegThrows godoc
+// @Summary Get throws of a leg
+// @Description Get every dart thrown in a leg in the order they were thrown
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Leg ID"
+// @Success 200 {object} http.Response
+// @Router /api/legs/{id}/throws [get]
+func (h *GameHandler) GetLegThrows(c *gin.Context) {
+	idStr := c.Param("id")
+	legID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid leg ID")
+		return
+	}
+
+	throws, err := h.useCases.Game.GetLegThrows(c.Request.Context(), legID)
+	if err != nil {
+		if err == entities.ErrLegNotFound {
+			http.NotFoundResponse(c, "Leg not found")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to get throws")
+		return
+	}
+
+	http.SuccessResponse(c, throws)
+}
+
+// ThrowDarts godoc
+// @Summary Throw darts
+// @Description Score darts in a leg under the rules of its game (X01, cricket, Around the Clock, Shanghai, Killer or Bob's 27); X01 legs follow the tournament's in- and out-rules and busts restore the turn's starting score. A winning dart completes the leg and updates the match
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Leg ID"
+// @Param request body dto.ThrowDartsRequest true "Darts in the order they were thrown"
+// @Success 201 {object} http.Response
+// @Router /api/legs/{id}/throws [post]
+func (h *GameHandler) ThrowDarts(c *gin.Context) {
+	idStr := c.Param("id")
+	legID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid leg ID")
+		return
+	}
+
+	var req dto.ThrowDartsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	result, err := h.useCases.Game.ThrowDarts(c.Request.Context(), legID, toDarts(req.Darts), req.RecordedBy)
+	if err != nil {
+		if err == entities.ErrLegNotFound {
+			http.NotFoundResponse(c, "Leg not found")
+			return
+		}
+		if err == entities.ErrLegNotInProgress {
+			http.BadRequestResponse(c, "Leg is not in progress")
+			return
+		}
+		if err == entities.ErrMatchNotInProgress {
+			http.BadRequestResponse(c, "Match is not in progress")
+			return
+		}
+		if err == entities.ErrInvalidDart {
+			http.BadRequestResponse(c, "Invalid dart - segment must be 0-20 or 25 with a valid multiplier")
+			return
+		}
+		if err == entities.ErrGameNotScored {
+			http.BadRequestResponse(c, "Throw-by-throw scoring is not available for this game type")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to record throws")
+		return
+	}
+
+	http.CreatedResponse(c, result)
+}
+
+// ScoreVisit godoc
+// @Summary Score a visit total
+// @Description Score the next visit of an X01 leg from its three-dart total instead of dart by dart. Totals that cannot be thrown with three darts are rejected, as are checkouts the out-rule makes impossible with the darts given. Under a double- or master-in, a player's opening darts must be scored one at a time
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Leg ID"
+// @Param request body dto.ScoreVisitRequest true "Visit total"
+// @Success 201 {object} http.Response
+// @Router /api/legs/{id}/visits [post]
+func (h *GameHandler) ScoreVisit(c *gin.Context) {
+	idStr := c.Param("id")
+	legID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid leg ID")
+		return
+	}
+
+	var req dto.ScoreVisitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	result, err := h.useCases.Game.ScoreVisit(c.Request.Context(), legID, toVisit(req.VisitRequest), req.RecordedBy)
+	if err != nil {
+		if err == entities.ErrLegNotFound {
+			http.NotFoundResponse(c, "Leg not found")
+			return
+		}
+		if err == entities.ErrLegNotInProgress {
+			http.BadRequestResponse(c, "Leg is not in progress")
+			return
+		}
+		if err == entities.ErrMatchNotInProgress {
+			http.BadRequestResponse(c, "Match is not in progress")
+			return
+		}
+		if respondVisitError(c, err) {
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to record visit")
+		return
+	}
+
+	http.CreatedResponse(c, result)
+}
+
+// GetLegEvents godoc
+// @Summary Get the scoring log of a leg
+// @Description Get every scoring action of a leg in order, including undos and edits with who made them and why
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Leg ID"
+// @Success 200 {object} http.Response
+// @Router /api/legs/{id}/events [get]
+func (h *GameHandler) GetLegEvents(c *gin.Context) {
+	idStr := c.Param("id")
+	legID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid leg ID")
+		return
+	}
+
+	events, err := h.useCases.Game.GetLegEvents(c.Request.Context(), legID)
+	if err != nil {
+		if err == entities.ErrLegNotFound {
+			http.NotFoundResponse(c, "Leg not found")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to get scoring log")
+		return
+	}
+
+	http.SuccessResponse(c, events)
+}
+
+// UndoThrows godoc
+// @Summary Undo the last dart or visit
+// @Description Remove the last dart or the whole last visit of the latest leg; the leg and match score are recomputed from the scoring log
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Leg ID"
+// @Param request body dto.UndoThrowsRequest true "Undo data"
+// @Success 200 {object} http.Response
+// @Router /api/legs/{id}/undo [post]
+func (h *GameHandler) UndoThrows(c *gin.Context) {
+	idStr := c.Param("id")
+	legID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid leg ID")
+		return
+	}
+
+	var req dto.UndoThrowsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	result, err := h.useCases.Game.UndoThrows(c.Request.Context(), legID, req.Scope == "visit", req.RecordedBy, req.Reason)
+	if err != nil {
+		if respondCorrectionError(c, err) {
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to undo throws")
+		return
+	}
+
+	http.SuccessResponse(c, result)
+}
+
+// EditVisit godoc
+// @Summary Edit a visit
+// @Description Replace a visit in the latest leg with its darts or, in visit mode, its total; later visits are scored again and the leg and match score are recomputed from the scoring log
+// @Tags legs
+// @Accept json
+// @Produce json
+// @Param id path string true "Leg ID"
+// @Param turn path int true "Turn number of the visit"
+// @Param request body dto.EditVisitRequest true "Corrected darts or total"
+// @Success 200 {object} http.Response
+// @Router /api/legs/{id}/visits/{turn} [put]
+func (h *GameHandler) EditVisit(c *gin.Context) {
+	idStr := c.Param("id")
+	legID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid leg ID")
+		return
+	}
+
+	turn, err := strconv.Atoi(c.Param("turn"))
+	if err != nil || turn < 1 {
+		http.BadRequestResponse(c, "Invalid turn number")
+		return
+	}
+
+	var req dto.EditVisitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	if (len(req.Darts) == 0) == (req.Visit == nil) {
+		http.BadRequestResponse(c, "Provide either the darts or the total of the visit")
+		return
+	}
+
+	var darts []entities.Dart
+	var visit *entities.Visit
+	if req.Visit != nil {
+		v := toVisit(*req.Visit)
+		visit = &v
+	} else {
+		darts = toDarts(req.Darts)
+	}
+
+	result, err := h.useCases.Game.EditVisit(c.Request.Context(), legID, turn, darts, visit, req.RecordedBy, req.Reason)
+	if err != nil {
+		if respondCorrectionError(c, err) || respondVisitError(c, err) {
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to edit visit")
+		return
+	}
+
+	http.SuccessResponse(c, result)
+}
+
+// toDarts converts requested darts; an omitted multiplier is a single
+func toDarts(req []dto.DartRequest) []entities.Dart {
+	darts := make([]entities.Dart, len(req))
+	for i, dart := range req {
+		darts[i] = entities.Dart{Segment: dart.Segment, Multiplier: dart.Multiplier}
+		if dart.Multiplier == 0 && dart.Segment != 0 {
+			darts[i].Multiplier = 1
+		}
+	}
+	return darts
+}
+
+// toVisit converts a requested visit total
+func toVisit(req dto.VisitRequest) entities.Visit {
+	return entities.Visit{Total: req.Total, Darts: req.Darts, Bust: req.Bust}
+}
+
+// respondCorrectionError writes the response for a rejected undo or edit and
+// reports whether it did
+func respondCorrectionError(c *gin.Context, err error) bool {
+	switch err {
+	case entities.ErrLegNotFound:
+		http.NotFoundResponse(c, "Leg not found")
+	case entities.ErrMatchNotInProgress:
+		http.BadRequestResponse(c, "Match is not in progress")
+	case entities.ErrLegClosed:
+		http.BadRequestResponse(c, "Only the latest leg of an ongoing match can be corrected")
+	case entities.ErrNothingToUndo:
+		http.BadRequestResponse(c, "There are no darts to undo")
+	case entities.ErrVisitNotFound:
+		http.NotFoundResponse(c, "Visit not found")
+	case entities.ErrInvalidDart:
+		http.BadRequestResponse(c, "Invalid dart - segment must be 0-20 or 25 with a valid multiplier")
+	case entities.ErrInvalidCorrection:
+		http.BadRequestResponse(c, "Correction would leave darts thrown after the leg was won")
+	default:
+		return false
+	}
+	return true
+}
+
+// respondVisitError writes the response for a rejected visit total and
+// reports whether it did
+func respondVisitError(c *gin.Context, err error) bool {
+	switch err {
+	case entities.ErrImpossibleVisit:
+		http.BadRequestResponse(c, "Visit total cannot be scored with three darts")
+	case entities.ErrImpossibleCheckout:
+		http.BadRequestResponse(c, "Checkout is not possible with the darts used under the out-rule")
+	case entities.ErrVisitInProgress:
+		http.BadRequestResponse(c, "The current visit is being scored dart by dart")
+	case entities.ErrVisitNeedsDarts:
+		http.BadRequestResponse(c, "Under a double- or master-in, score darts one at a time until the player has opened")
+	case entities.ErrGameNotScored:
+		http.BadRequestResponse(c, "Visit scoring is only available for X01 games")
+	default:
+		return false
+	}
+	return true
+}
@@ -92,8 +92,31 @@ func (h *LeagueHandler) CreateLeague(c *gin.Context) {
 		return
 	}
 
-	league, err := h.useCases.League.CreateLeague(c.Request.Context(), req.Name, req.Description, req.Season)
+	points := usecases.LeaguePointsSettings{
+		Schedule:            entities.PointsSchedule(req.PointsSchedule),
+		ParticipationPoints: req.ParticipationPoints,
+		PointsPerMatchWin:   req.PointsPerMatchWin,
+	}
+	if req.StandingsTiebreakers != nil {
+		points.StandingsTiebreakers = make([]entities.StandingsTiebreaker, len(req.StandingsTiebreakers))
+		for i, tiebreaker := range req.StandingsTiebreakers {
+			points.StandingsTiebreakers[i] = entities.StandingsTiebreaker(tiebreaker)
+		}
+	}
+	league, err := h.useCases.League.CreateLeague(c.Request.Context(), req.Name, req.Description, req.Season, points)
 	if err != nil {
+		if err == entities.ErrInvalidPointsSchedule {
+			http.BadRequestResponse(c, "Invalid points schedule")
+			return
+		}
+		if err == entities.ErrInvalidStandingsTiebreaker {
+			http.BadRequestResponse(c, "Unknown standings tiebreaker - use tournaments_won, finals_reached, semi_finals_reached, head_to_head or league_average")
+			return
+		}
+		if err == entities.ErrDuplicateStandingsTiebreaker {
+			http.BadRequestResponse(c, "Standings tiebreakers must be unique")
+			return
+		}
 		http.InternalErrorResponse(c, "Failed to create league")
 		return
 	}
@@ -101,6 +124,70 @@ func (h *LeagueHandler) CreateLeague(c *gin.Context) {
 	http.CreatedResponse(c, league)
 }
 
+// UpdateLeaguePoints godoc
+// @Summary Update league points system
+// @Description Change the points schedule and bonus points of a league that has not started yet, or its standings tiebreakers
+// @Tags leagues
+// @Accept json
+// @Produce json
+// @Param id path string true "League ID"
+// @Param request body dto.UpdateLeaguePointsRequest true "Points system"
+// @Success 200 {object} http.Response
+// @Router /api/leagues/{id}/points [put]
+func (h *LeagueHandler) UpdateLeaguePoints(c *gin.Context) {
+	idStr := c.Param("id")
+	leagueID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid league ID")
+		return
+	}
+
+	var req dto.UpdateLeaguePointsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	points := usecases.LeaguePointsSettings{
+		Schedule:            entities.PointsSchedule(req.PointsSchedule),
+		ParticipationPoints: req.ParticipationPoints,
+		PointsPerMatchWin:   req.PointsPerMatchWin,
+	}
+	if req.StandingsTiebreakers != nil {
+		points.StandingsTiebreakers = make([]entities.StandingsTiebreaker, len(req.StandingsTiebreakers))
+		for i, tiebreaker := range req.StandingsTiebreakers {
+			points.StandingsTiebreakers[i] = entities.StandingsTiebreaker(tiebreaker)
+		}
+	}
+	league, err := h.useCases.League.UpdateLeaguePoints(c.Request.Context(), leagueID, points)
+	if err != nil {
+		if err == entities.ErrLeagueNotFound {
+			http.NotFoundResponse(c, "League not found")
+			return
+		}
+		if err == entities.ErrLeagueAlreadyStarted {
+			http.BadRequestResponse(c, "Points can only be changed before the league starts")
+			return
+		}
+		if err == entities.ErrInvalidPointsSchedule {
+			http.BadRequestResponse(c, "Invalid points schedule")
+			return
+		}
+		if err == entities.ErrInvalidStandingsTiebreaker {
+			http.BadRequestResponse(c, "Unknown standings tiebreaker - use tournaments_won, finals_reached, semi_finals_reached, head_to_head or league_average")
+			return
+		}
+		if err == entities.ErrDuplicateStandingsTiebreaker {
+			http.BadRequestResponse(c, "Standings tiebreakers must be unique")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to update league points")
+		return
+	}
+
+	http.SuccessResponse(c, league)
+}
+
 // AddPlayerToLeague godoc
 // @Summary Add player to league
 // @Description Add a player to a league
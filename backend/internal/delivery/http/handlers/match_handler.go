@@ -20,7 +20,7 @@ func NewMatchHandler(useCases *usecases.UseCases) *MatchHandler {
 
 // CreateMatch godoc
 // @Summary Create a new match
-// @Description Create a new match between two players
+// @Description Create a new match between two players; standalone matches may choose their game type (default 501)
 // @Tags matches
 // @Accept json
 // @Produce json
@@ -43,6 +43,18 @@ func (h *MatchHandler) CreateMatch(c *gin.Context) {
 		match = entities.NewMatch(uuid.Nil, 1, 1)
 	}
 
+	// Standalone matches choose their own game
+	if req.GameType != "" {
+		if req.TournamentID != nil {
+			http.BadRequestResponse(c, "Tournament matches play the tournament's game type")
+			return
+		}
+		if err := match.SetGameType(entities.GameType(req.GameType)); err != nil {
+			http.BadRequestResponse(c, "Unsupported game type")
+			return
+		}
+	}
+
 	// Set players if provided
 	if req.Player1ID != nil && req.Player2ID != nil {
 		if err := match.SetPlayers(*req.Player1ID, *req.Player2ID); err != nil {
@@ -118,7 +130,7 @@ func (h *MatchHandler) GetTournamentMatches(c *gin.Context) {
 
 // StartMatch godoc
 // @Summary Start a match
-// @Description Start a match with two players
+// @Description Start a match with two players; a tournament match starts with the players drawn into it once both are known
 // @Tags matches
 // @Accept json
 // @Produce json
@@ -150,6 +162,14 @@ func (h *MatchHandler) StartMatch(c *gin.Context) {
 			http.BadRequestResponse(c, "Match has already been started")
 			return
 		}
+		if err == entities.ErrMatchMissingPlayers {
+			http.BadRequestResponse(c, "Match is still waiting for its players")
+			return
+		}
+		if err == entities.ErrPlayerNotInMatch {
+			http.BadRequestResponse(c, "Players do not match the players drawn into this match")
+			return
+		}
 		http.InternalErrorResponse(c, "Failed to start match")
 		return
 	}
@@ -159,7 +179,7 @@ func (h *MatchHandler) StartMatch(c *gin.Context) {
 
 // UpdateMatchScore godoc
 // @Summary Update match score
-// @Description Update the score of an ongoing match
+// @Description Update the score of an ongoing match; the match completes automatically once the score decides it
 // @Tags matches
 // @Accept json
 // @Produce json
@@ -191,6 +211,14 @@ func (h *MatchHandler) UpdateMatchScore(c *gin.Context) {
 			http.BadRequestResponse(c, "Match is not in progress")
 			return
 		}
+		if err == entities.ErrInvalidScore {
+			http.BadRequestResponse(c, "Score is not possible in this match format")
+			return
+		}
+		if err == entities.ErrScoreFromLegs {
+			http.BadRequestResponse(c, "Match score is derived from its legs")
+			return
+		}
 		http.InternalErrorResponse(c, "Failed to update match score")
 		return
 	}
@@ -243,6 +271,222 @@ func (h *MatchHandler) CompleteMatch(c *gin.Context) {
 	http.SuccessResponse(c, match)
 }
 
+// AwardMatch godoc
+// @Summary Award a match
+// @Description Complete a match by walkover, forfeit or retirement; the winner advances as usual and the match is left out of statistics
+// @Tags matches
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param request body dto.AwardMatchRequest true "Match award data"
+// @Success 200 {object} http.Response
+// @Router /api/matches/{id}/award [post]
+func (h *MatchHandler) AwardMatch(c *gin.Context) {
+	idStr := c.Param("id")
+	matchID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid match ID")
+		return
+	}
+
+	var req dto.AwardMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	match, err := h.useCases.Match.AwardMatch(c.Request.Context(), matchID, req.WinnerID, entities.MatchOutcome(req.Outcome), req.Reason)
+	if err != nil {
+		if err == entities.ErrMatchNotFound {
+			http.NotFoundResponse(c, "Match not found")
+			return
+		}
+		if err == entities.ErrMatchNotInProgress {
+			http.BadRequestResponse(c, "Match cannot be awarded in its current state")
+			return
+		}
+		if err == entities.ErrMatchMissingPlayers {
+			http.BadRequestResponse(c, "Match requires both players to be set")
+			return
+		}
+		if err == entities.ErrInvalidWinner {
+			http.BadRequestResponse(c, "Invalid winner - must be one of the match participants")
+			return
+		}
+		if err == entities.ErrInvalidMatchOutcome {
+			http.BadRequestResponse(c, "Unsupported match outcome")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to award match")
+		return
+	}
+
+	http.SuccessResponse(c, match)
+}
+
+// RecordThrowOff godoc
+// @Summary Record the throw-off
+// @Description Record the bull-off or coin toss that decides who throws first in the first leg; later legs alternate. It can be corrected until the first leg starts
+// @Tags matches
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param request body dto.RecordThrowOffRequest true "Throw-off result"
+// @Success 200 {object} http.Response
+// @Router /api/matches/{id}/throw-off [post]
+func (h *MatchHandler) RecordThrowOff(c *gin.Context) {
+	idStr := c.Param("id")
+	matchID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid match ID")
+		return
+	}
+
+	var req dto.RecordThrowOffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	match, err := h.useCases.Match.RecordThrowOff(c.Request.Context(), matchID, entities.ThrowOffMethod(req.Method), req.WinnerID)
+	if err != nil {
+		if err == entities.ErrMatchNotFound {
+			http.NotFoundResponse(c, "Match not found")
+			return
+		}
+		if err == entities.ErrMatchNotInProgress {
+			http.BadRequestResponse(c, "Match is already completed")
+			return
+		}
+		if err == entities.ErrMatchMissingPlayers {
+			http.BadRequestResponse(c, "Match requires both players to be set")
+			return
+		}
+		if err == entities.ErrInvalidWinner {
+			http.BadRequestResponse(c, "Invalid winner - must be one of the match participants")
+			return
+		}
+		if err == entities.ErrInvalidThrowOff {
+			http.BadRequestResponse(c, "Throw-off must be a bull-off or coin toss")
+			return
+		}
+		if err == entities.ErrThrowOffTooLate {
+			http.BadRequestResponse(c, "Throw-off must be recorded before the first leg")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to record throw-off")
+		return
+	}
+
+	http.SuccessResponse(c, match)
+}
+
+// SetHandicaps godoc
+// @Summary Set handicaps
+// @Description Set each player's head start by hand: a lower start score for X01 legs, legs already awarded, or both. Handicaps can be corrected until the first leg starts
+// @Tags matches
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param request body dto.SetHandicapsRequest true "Handicaps"
+// @Success 200 {object} http.Response
+// @Router /api/matches/{id}/handicap [put]
+func (h *MatchHandler) SetHandicaps(c *gin.Context) {
+	idStr := c.Param("id")
+	matchID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid match ID")
+		return
+	}
+
+	var req dto.SetHandicapsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	match, err := h.useCases.Match.SetHandicaps(c.Request.Context(), matchID, toHandicap(req.Player1), toHandicap(req.Player2))
+	if err != nil {
+		if !respondHandicapError(c, err) {
+			http.InternalErrorResponse(c, "Failed to set handicaps")
+		}
+		return
+	}
+
+	http.SuccessResponse(c, match)
+}
+
+// ComputeHandicaps godoc
+// @Summary Compute handicaps
+// @Description Work out the weaker player's head start, as a lower start score or legs awarded, from a handicap table and both players' league averages. The default table gives 60 points or a leg for every ten points of average below 60
+// @Tags matches
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID"
+// @Param request body dto.ComputeHandicapsRequest true "Handicap method and table"
+// @Success 200 {object} http.Response
+// @Router /api/matches/{id}/handicap/compute [post]
+func (h *MatchHandler) ComputeHandicaps(c *gin.Context) {
+	idStr := c.Param("id")
+	matchID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid match ID")
+		return
+	}
+
+	var req dto.ComputeHandicapsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	table := make(entities.HandicapTable, 0, len(req.Table))
+	for _, band := range req.Table {
+		table = append(table, entities.HandicapBand{MinAverage: band.MinAverage, Reduction: band.Reduction, Legs: band.Legs})
+	}
+
+	match, err := h.useCases.Match.ComputeHandicaps(c.Request.Context(), matchID, entities.HandicapMethod(req.Method), table)
+	if err != nil {
+		if err == entities.ErrHandicapNoLeague {
+			http.BadRequestResponse(c, "Handicaps can only be computed for league matches")
+			return
+		}
+		if !respondHandicapError(c, err) {
+			http.InternalErrorResponse(c, "Failed to compute handicaps")
+		}
+		return
+	}
+
+	http.SuccessResponse(c, match)
+}
+
+// toHandicap converts a handicap request to a handicap
+func toHandicap(req dto.HandicapRequest) entities.Handicap {
+	return entities.Handicap{StartScore: req.StartScore, LegsAwarded: req.LegsAwarded}
+}
+
+// respondHandicapError writes the response for an error setting handicaps,
+// returning false if the error is not a handicap error
+func respondHandicapError(c *gin.Context, err error) bool {
+	switch err {
+	case entities.ErrMatchNotFound:
+		http.NotFoundResponse(c, "Match not found")
+	case entities.ErrMatchNotInProgress:
+		http.BadRequestResponse(c, "Match is already completed")
+	case entities.ErrMatchMissingPlayers:
+		http.BadRequestResponse(c, "Match requires both players to be set")
+	case entities.ErrInvalidHandicap:
+		http.BadRequestResponse(c, "Handicap start score must be above 1 and legs awarded cannot decide a set or the match")
+	case entities.ErrHandicapTooLate:
+		http.BadRequestResponse(c, "Handicap must be set before the first leg")
+	case entities.ErrHandicapNotX01:
+		http.BadRequestResponse(c, "Start score handicaps only apply to X01 games")
+	default:
+		return false
+	}
+	return true
+}
+
 // GetPlayerMatches godoc
 // @Summary Get matches for a player
 // @Description Get all matches for a specific player
@@ -125,12 +125,20 @@ func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 		return
 	}
 
-	player, err := h.useCases.Player.UpdatePlayer(c.Request.Context(), id, req.Name, req.Email, req.Nickname, req.AvatarURL)
+	player, err := h.useCases.Player.UpdatePlayer(c.Request.Context(), id, req.Name, req.Email, req.Nickname, req.AvatarURL, req.Rating)
 	if err != nil {
 		if err == entities.ErrPlayerNotFound {
 			http.NotFoundResponse(c, "Player not found")
 			return
 		}
+		if err == entities.ErrInvalidPlayerName {
+			http.BadRequestResponse(c, "Player name cannot be empty")
+			return
+		}
+		if err == entities.ErrInvalidRating {
+			http.BadRequestResponse(c, "Rating cannot be negative")
+			return
+		}
 		http.InternalErrorResponse(c, "Failed to update player")
 		return
 	}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"darts-league-backend/internal/delivery/http"
+	"darts-league-backend/internal/delivery/http/dto"
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/usecases"
+)
+
+type StatisticsHandler struct {
+	useCases *usecases.UseCases
+}
+
+func NewStatisticsHandler(useCases *usecases.UseCases) *StatisticsHandler {
+	return &StatisticsHandler{useCases: useCases}
+}
+
+// GetTournamentStats godoc
+// @Summary Get tournament statistics
+// @Description Get every player's statistics in a tournament: averages, checkout percentage, best finish and hits, computed from the recorded legs and throws. Awarded matches are left out
+// @Tags statistics
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Success 200 {object} http.Response
+// @Router /api/tournaments/{id}/stats [get]
+func (h *StatisticsHandler) GetTournamentStats(c *gin.Context) {
+	idStr := c.Param("id")
+	tournamentID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid tournament ID")
+		return
+	}
+
+	stats, err := h.useCases.Statistics.GetTournamentStats(c.Request.Context(), tournamentID)
+	if err != nil {
+		if err == entities.ErrTournamentNotFound {
+			http.NotFoundResponse(c, "Tournament not found")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to get tournament statistics")
+		return
+	}
+
+	http.SuccessResponse(c, stats)
+}
+
+// GetPlayerStats godoc
+// @Summary Get player statistics
+// @Description Get a player's statistics in each tournament they have played, most recent first
+// @Tags statistics
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} http.PaginatedResponse
+// @Router /api/players/{id}/stats [get]
+func (h *StatisticsHandler) GetPlayerStats(c *gin.Context) {
+	idStr := c.Param("id")
+	playerID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid player ID")
+		return
+	}
+
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		http.BadRequestResponse(c, "Invalid query parameters")
+		return
+	}
+
+	stats, err := h.useCases.Statistics.GetPlayerStats(c.Request.Context(), playerID, query.Limit, query.GetOffset())
+	if err != nil {
+		if err == entities.ErrPlayerNotFound {
+			http.NotFoundResponse(c, "Player not found")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to get player statistics")
+		return
+	}
+
+	http.PaginatedSuccessResponse(c, stats, query.Page, query.Limit, 0)
+}
+
+// GetLeaderboard godoc
+// @Summary Get a league leaderboard
+// @Description Rank a league's players by three-dart average, checkout percentage, 180s or best finish, with the league's average player as a benchmark for each metric
+// @Tags statistics
+// @Accept json
+// @Produce json
+// @Param id path string true "League ID"
+// @Param metric query string true "Metric" Enums(average, checkout, 180s, best_finish)
+// @Param limit query int false "Players to show" default(10)
+// @Success 200 {object} http.Response
+// @Router /api/leagues/{id}/leaderboards [get]
+func (h *StatisticsHandler) GetLeaderboard(c *gin.Context) {
+	idStr := c.Param("id")
+	leagueID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid league ID")
+		return
+	}
+
+	var query dto.LeaderboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		http.BadRequestResponse(c, "Metric must be average, checkout, 180s or best_finish")
+		return
+	}
+
+	leaderboard, err := h.useCases.Statistics.GetLeaderboard(c.Request.Context(), leagueID, entities.LeaderboardMetric(query.Metric), query.Limit)
+	if err != nil {
+		if err == entities.ErrLeagueNotFound {
+			http.NotFoundResponse(c, "League not found")
+			return
+		}
+		if err == entities.ErrInvalidMetric {
+			http.BadRequestResponse(c, "Metric must be average, checkout, 180s or best_finish")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to get leaderboard")
+		return
+	}
+
+	http.SuccessResponse(c, leaderboard)
+}
+
+// GetHeadToHead godoc
+// @Summary Get a head-to-head between two players
+// @Description Get every match two players have played against each other, the legs each has won in them and their averages in those meetings. Given a league, only the league's matches count and both players' league statistics are shown side by side
+// @Tags statistics
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param other_id path string true "Opponent ID"
+// @Param league query string false "League ID"
+// @Success 200 {object} http.Response
+// @Router /api/players/{id}/versus/{other_id} [get]
+func (h *StatisticsHandler) GetHeadToHead(c *gin.Context) {
+	playerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid player ID")
+		return
+	}
+	opponentID, err := uuid.Parse(c.Param("other_id"))
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid opponent ID")
+		return
+	}
+
+	var query dto.HeadToHeadQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		http.BadRequestResponse(c, "Invalid query parameters")
+		return
+	}
+	var leagueID *uuid.UUID
+	if query.League != "" {
+		id, err := uuid.Parse(query.League)
+		if err != nil {
+			http.BadRequestResponse(c, "Invalid league ID")
+			return
+		}
+		leagueID = &id
+	}
+
+	headToHead, err := h.useCases.Statistics.GetHeadToHead(c.Request.Context(), playerID, opponentID, leagueID)
+	if err != nil {
+		switch err {
+		case entities.ErrSamePlayer:
+			http.BadRequestResponse(c, "A player cannot be compared with themselves")
+		case entities.ErrPlayerNotFound:
+			http.NotFoundResponse(c, "Player not found")
+		case entities.ErrLeagueNotFound:
+			http.NotFoundResponse(c, "League not found")
+		default:
+			http.InternalErrorResponse(c, "Failed to get head-to-head")
+		}
+		return
+	}
+
+	http.SuccessResponse(c, headToHead)
+}
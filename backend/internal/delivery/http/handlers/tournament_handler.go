@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"log"
 
 	"github.com/gin-gonic/gin"
@@ -69,8 +71,12 @@ func (h *TournamentHandler) CreateTournament(c *gin.Context) {
 
 	tournamentType := entities.TournamentType(req.Type)
 	log.Println(req.LeagueID, req.Name, tournamentType)
-	tournament, err := h.useCases.Tournament.CreateTournament(c.Request.Context(), req.LeagueID, req.Name, tournamentType)
+	settings := toTournamentSettings(req.TournamentSettingsRequest)
+	tournament, err := h.useCases.Tournament.CreateTournament(c.Request.Context(), req.LeagueID, req.Name, tournamentType, settings)
 	if err != nil {
+		if respondTournamentSettingsError(c, err) {
+			return
+		}
 		http.InternalErrorResponse(c, "Failed to create tournament")
 		return
 	}
@@ -78,6 +84,55 @@ func (h *TournamentHandler) CreateTournament(c *gin.Context) {
 	http.CreatedResponse(c, tournament)
 }
 
+// UpdateTournament godoc
+// @Summary Update a tournament
+// @Description Rename a tournament or change its format, match length, game and X01 rules before it starts
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Param request body dto.UpdateTournamentRequest true "Updated tournament data"
+// @Success 200 {object} http.Response
+// @Router /api/tournaments/{id} [put]
+func (h *TournamentHandler) UpdateTournament(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid tournament ID")
+		return
+	}
+
+	var req dto.UpdateTournamentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	settings := toTournamentSettings(req.TournamentSettingsRequest)
+	tournament, err := h.useCases.Tournament.UpdateTournament(c.Request.Context(), id, req.Name, settings)
+	if err != nil {
+		if err == entities.ErrTournamentNotFound {
+			http.NotFoundResponse(c, "Tournament not found")
+			return
+		}
+		if err == entities.ErrTournamentAlreadyStarted {
+			http.BadRequestResponse(c, "Tournament has already started")
+			return
+		}
+		if err == entities.ErrInvalidTournamentName {
+			http.BadRequestResponse(c, "Tournament name cannot be empty")
+			return
+		}
+		if respondTournamentSettingsError(c, err) {
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to update tournament")
+		return
+	}
+
+	http.SuccessResponse(c, tournament)
+}
+
 // GetLeagueTournaments godoc
 // @Summary Get tournaments for a league
 // @Description Get all tournaments in a specific league
@@ -128,7 +183,7 @@ func (h *TournamentHandler) AddPlayerToTournament(c *gin.Context) {
 		return
 	}
 
-	err = h.useCases.Tournament.AddPlayerToTournament(c.Request.Context(), tournamentID, req.PlayerID)
+	err = h.useCases.Tournament.AddPlayerToTournament(c.Request.Context(), tournamentID, req.PlayerID, req.Seed)
 	if err != nil {
 		if err == entities.ErrTournamentAlreadyStarted {
 			http.BadRequestResponse(c, "Cannot add players to a tournament that has already started")
@@ -143,11 +198,12 @@ func (h *TournamentHandler) AddPlayerToTournament(c *gin.Context) {
 
 // StartTournament godoc
 // @Summary Start a tournament
-// @Description Start a tournament and generate the bracket
+// @Description Seed the players, start a tournament and generate the bracket
 // @Tags tournaments
 // @Accept json
 // @Produce json
 // @Param id path string true "Tournament ID"
+// @Param request body dto.StartTournamentRequest false "Seeding options"
 // @Success 200 {object} http.Response
 // @Router /api/tournaments/{id}/start [post]
 func (h *TournamentHandler) StartTournament(c *gin.Context) {
@@ -158,7 +214,29 @@ func (h *TournamentHandler) StartTournament(c *gin.Context) {
 		return
 	}
 
-	tournament, err := h.useCases.Tournament.StartTournament(c.Request.Context(), tournamentID)
+	// The seeding options are optional; an empty body keeps registration order
+	var req dto.StartTournamentRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		http.BadRequestResponse(c, "Invalid request data")
+		return
+	}
+
+	seeding := usecases.SeedingOptions{
+		Method:     entities.SeedingMethod(req.SeedingMethod),
+		RandomSeed: req.RandomSeed,
+	}
+	if len(req.Seeds) > 0 {
+		seeding.ManualSeeds = make(map[uuid.UUID]int, len(req.Seeds))
+		for _, seed := range req.Seeds {
+			seeding.ManualSeeds[seed.PlayerID] = seed.Seed
+		}
+		if len(seeding.ManualSeeds) != len(req.Seeds) {
+			http.BadRequestResponse(c, "Each player can only be seeded once")
+			return
+		}
+	}
+
+	tournament, err := h.useCases.Tournament.StartTournament(c.Request.Context(), tournamentID, seeding)
 	if err != nil {
 		if err == entities.ErrTournamentNotFound {
 			http.NotFoundResponse(c, "Tournament not found")
@@ -168,9 +246,155 @@ func (h *TournamentHandler) StartTournament(c *gin.Context) {
 			http.BadRequestResponse(c, "Tournament has already been started")
 			return
 		}
+		if err == entities.ErrNotEnoughPlayers {
+			http.BadRequestResponse(c, "Tournament requires at least two players")
+			return
+		}
+		if err == entities.ErrInvalidGroupSettings {
+			http.BadRequestResponse(c, "Group settings do not fit the number of players")
+			return
+		}
+		if err == entities.ErrInvalidSwissRounds {
+			http.BadRequestResponse(c, "Swiss round count does not fit the number of players")
+			return
+		}
+		if err == entities.ErrInvalidSeedingMethod {
+			http.BadRequestResponse(c, "Unsupported seeding method")
+			return
+		}
+		if err == entities.ErrInvalidSeeds {
+			http.BadRequestResponse(c, "Seeds must be unique, between 1 and the number of players, and name tournament players")
+			return
+		}
 		http.InternalErrorResponse(c, "Failed to start tournament")
 		return
 	}
 
 	http.SuccessResponse(c, tournament)
-}
\ No newline at end of file
+}
+
+// GenerateNextRound godoc
+// @Summary Generate next round
+// @Description Pair the next round of a Swiss tournament once the current round is completed
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Success 201 {object} http.Response
+// @Router /api/tournaments/{id}/rounds/next [post]
+func (h *TournamentHandler) GenerateNextRound(c *gin.Context) {
+	idStr := c.Param("id")
+	tournamentID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid tournament ID")
+		return
+	}
+
+	matches, err := h.useCases.Tournament.GenerateNextRound(c.Request.Context(), tournamentID)
+	if err != nil {
+		if err == entities.ErrTournamentNotFound {
+			http.NotFoundResponse(c, "Tournament not found")
+			return
+		}
+		if err == entities.ErrRoundsNotSupported {
+			http.BadRequestResponse(c, "Only Swiss tournaments generate rounds on demand")
+			return
+		}
+		if err == entities.ErrTournamentNotInProgress {
+			http.BadRequestResponse(c, "Tournament is not in progress")
+			return
+		}
+		if err == entities.ErrRoundNotComplete {
+			http.BadRequestResponse(c, "Current round still has unfinished matches")
+			return
+		}
+		if err == entities.ErrNoMoreRounds {
+			http.BadRequestResponse(c, "All rounds have already been played")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to generate next round")
+		return
+	}
+
+	http.CreatedResponse(c, matches)
+}
+
+// GetTournamentTable godoc
+// @Summary Get tournament table
+// @Description Get the round-robin table computed from completed matches
+// @Tags tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Success 200 {object} http.Response
+// @Router /api/tournaments/{id}/table [get]
+func (h *TournamentHandler) GetTournamentTable(c *gin.Context) {
+	idStr := c.Param("id")
+	tournamentID, err := uuid.Parse(idStr)
+	if err != nil {
+		http.BadRequestResponse(c, "Invalid tournament ID")
+		return
+	}
+
+	table, err := h.useCases.Tournament.GetTournamentTable(c.Request.Context(), tournamentID)
+	if err != nil {
+		if err == entities.ErrTournamentNotFound {
+			http.NotFoundResponse(c, "Tournament not found")
+			return
+		}
+		http.InternalErrorResponse(c, "Failed to get tournament table")
+		return
+	}
+
+	http.SuccessResponse(c, table)
+}
+
+// toTournamentSettings converts the optional settings of a create or update
+// request
+func toTournamentSettings(req dto.TournamentSettingsRequest) usecases.TournamentSettings {
+	settings := usecases.TournamentSettings{
+		GrandFinalReset:    req.GrandFinalReset,
+		GroupCount:         req.GroupCount,
+		QualifiersPerGroup: req.QualifiersPerGroup,
+		SwissRounds:        req.SwissRounds,
+		MatchFormat:        entities.MatchFormat(req.MatchFormat),
+		LegsPerMatch:       req.LegsPerMatch,
+		SetsPerMatch:       req.SetsPerMatch,
+		FinalSetTiebreak:   req.FinalSetTiebreak,
+		GameType:           entities.GameType(req.GameType),
+		StartScore:         req.StartScore,
+		InRule:             entities.CheckRule(req.InRule),
+		OutRule:            entities.CheckRule(req.OutRule),
+	}
+	if req.Tiebreakers != nil {
+		settings.Tiebreakers = make([]entities.TableTiebreaker, len(req.Tiebreakers))
+		for i, tiebreaker := range req.Tiebreakers {
+			settings.Tiebreakers[i] = entities.TableTiebreaker(tiebreaker)
+		}
+	}
+	return settings
+}
+
+// respondTournamentSettingsError writes the response for a rejected
+// tournament setting and reports whether it did
+func respondTournamentSettingsError(c *gin.Context, err error) bool {
+	switch err {
+	case entities.ErrInvalidTiebreaker:
+		http.BadRequestResponse(c, "Unknown tiebreaker - use leg_difference, legs_won, head_to_head, matches_won, buchholz or sonneborn_berger")
+	case entities.ErrDuplicateTiebreaker:
+		http.BadRequestResponse(c, "Tiebreakers must be unique")
+	case entities.ErrInvalidGroupSettings:
+		http.BadRequestResponse(c, "Invalid group settings")
+	case entities.ErrInvalidSwissRounds:
+		http.BadRequestResponse(c, "Invalid number of Swiss rounds")
+	case entities.ErrInvalidMatchFormat:
+		http.BadRequestResponse(c, "Best-of matches need an odd number of legs and sets; fixed legs are only available in round-robin and Swiss tournaments and have no final-set tiebreak")
+	case entities.ErrInvalidGameType:
+		http.BadRequestResponse(c, "Unsupported game type")
+	case entities.ErrInvalidX01Rules:
+		http.BadRequestResponse(c, "Starting score and in/out rules only apply to X01 games")
+	default:
+		return false
+	}
+	return true
+}
@@ -20,6 +20,8 @@ func SetupRoutes(router *gin.Engine, useCases *usecases.UseCases) {
 	leagueHandler := handlers.NewLeagueHandler(useCases)
 	tournamentHandler := handlers.NewTournamentHandler(useCases)
 	matchHandler := handlers.NewMatchHandler(useCases)
+	gameHandler := handlers.NewGameHandler(useCases)
+	statisticsHandler := handlers.NewStatisticsHandler(useCases)
 
 	// Health check
 	router.GET("/health", func(c *gin.Context) {
@@ -43,6 +45,8 @@ func SetupRoutes(router *gin.Engine, useCases *usecases.UseCases) {
 			players.PUT("/:id", playerHandler.UpdatePlayer)
 			players.DELETE("/:id", playerHandler.DeletePlayer)
 			players.GET("/:id/matches", matchHandler.GetPlayerMatches) // Use :id instead of :player_id
+			players.GET("/:id/stats", statisticsHandler.GetPlayerStats)
+			players.GET("/:id/versus/:other_id", statisticsHandler.GetHeadToHead)
 		}
 
 		// League routes - FIXED: use consistent parameter names
@@ -51,8 +55,10 @@ func SetupRoutes(router *gin.Engine, useCases *usecases.UseCases) {
 			leagues.GET("", leagueHandler.GetLeagues)
 			leagues.POST("", leagueHandler.CreateLeague)
 			leagues.GET("/:id", leagueHandler.GetLeague)
+			leagues.PUT("/:id/points", leagueHandler.UpdateLeaguePoints)
 			leagues.POST("/:id/players", leagueHandler.AddPlayerToLeague)
 			leagues.GET("/:id/standings", leagueHandler.GetLeagueStandings)
+			leagues.GET("/:id/leaderboards", statisticsHandler.GetLeaderboard)
 			leagues.POST("/:id/start", leagueHandler.StartLeague)
 			leagues.GET("/:id/tournaments", tournamentHandler.GetLeagueTournaments) // Use :id instead of :league_id
 		}
@@ -62,9 +68,13 @@ func SetupRoutes(router *gin.Engine, useCases *usecases.UseCases) {
 		{
 			tournaments.POST("", tournamentHandler.CreateTournament)
 			tournaments.GET("/:id", tournamentHandler.GetTournament)
+			tournaments.PUT("/:id", tournamentHandler.UpdateTournament)
 			tournaments.POST("/:id/players", tournamentHandler.AddPlayerToTournament)
 			tournaments.POST("/:id/start", tournamentHandler.StartTournament)
+			tournaments.POST("/:id/rounds/next", tournamentHandler.GenerateNextRound)
 			tournaments.GET("/:id/matches", matchHandler.GetTournamentMatches) // Use :id instead of :tournament_id
+			tournaments.GET("/:id/table", tournamentHandler.GetTournamentTable)
+			tournaments.GET("/:id/stats", statisticsHandler.GetTournamentStats)
 		}
 
 		// Match routes
@@ -75,6 +85,33 @@ func SetupRoutes(router *gin.Engine, useCases *usecases.UseCases) {
 			matches.POST("/:id/start", matchHandler.StartMatch)
 			matches.PUT("/:id/score", matchHandler.UpdateMatchScore)
 			matches.POST("/:id/complete", matchHandler.CompleteMatch)
+			matches.POST("/:id/award", matchHandler.AwardMatch)
+			matches.POST("/:id/throw-off", matchHandler.RecordThrowOff)
+			matches.PUT("/:id/handicap", matchHandler.SetHandicaps)
+			matches.POST("/:id/handicap/compute", matchHandler.ComputeHandicaps)
+
+			// Legs of a match
+			matches.GET("/:id/legs", gameHandler.GetMatchLegs)
+			matches.POST("/:id/legs", gameHandler.StartLeg)
+			matches.POST("/:id/legs/:leg_id/complete", gameHandler.CompleteLeg)
+		}
+
+		// Leg routes
+		legs := api.Group("/legs")
+		{
+			legs.GET("/:id", gameHandler.GetLegState)
+			legs.GET("/:id/throws", gameHandler.GetLegThrows)
+			legs.POST("/:id/throws", gameHandler.ThrowDarts)
+			legs.GET("/:id/events", gameHandler.GetLegEvents)
+			legs.POST("/:id/undo", gameHandler.UndoThrows)
+			legs.POST("/:id/visits", gameHandler.ScoreVisit)
+			legs.PUT("/:id/visits/:turn", gameHandler.EditVisit)
+		}
+
+		// Checkout routes
+		checkouts := api.Group("/checkouts")
+		{
+			checkouts.GET("/:remaining", gameHandler.GetCheckout)
 		}
 	}
 }
\ No newline at end of file
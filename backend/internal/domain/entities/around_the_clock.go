@@ -0,0 +1,55 @@
+package entities
+
+import "github.com/google/uuid"
+
+// AroundTheClockTargets are hit in order, 1 to 20 and then the bull
+var AroundTheClockTargets = func() []int {
+	targets := make([]int, 0, 21)
+	for segment := 1; segment <= 20; segment++ {
+		targets = append(targets, segment)
+	}
+	return append(targets, Bull)
+}()
+
+// AroundTheClockRules are the rules of Around the Clock, a training game.
+// Each player's score is the number of targets they have hit; any dart in
+// the current target's segment moves them on to the next.
+type AroundTheClockRules struct{}
+
+// Start puts both players on the first target
+func (AroundTheClockRules) Start(leg *Game) {
+	leg.Player1Score = 0
+	leg.Player2Score = 0
+}
+
+// Score moves the thrower on to their next target if the dart hit the
+// current one
+func (AroundTheClockRules) Score(leg *Game, match *Match, throws []*Throw, throw *Throw) error {
+	hit := leg.PlayerScore(match, throw.PlayerID)
+	throw.Score = 0
+	if target, ok := AroundTheClockTarget(hit); ok && throw.Segment == target {
+		throw.Score = 1
+		hit++
+	}
+
+	throw.RemainingScore = hit
+	leg.setPlayerScore(match, throw.PlayerID, hit)
+	return nil
+}
+
+// Winner returns the thrower once they have hit the bull
+func (AroundTheClockRules) Winner(leg *Game, match *Match, throws []*Throw, throw *Throw) *uuid.UUID {
+	if throw.RemainingScore < len(AroundTheClockTargets) {
+		return nil
+	}
+	return &throw.PlayerID
+}
+
+// AroundTheClockTarget returns the target after the given number of hits;
+// false once every target has been hit
+func AroundTheClockTarget(hit int) (int, bool) {
+	if hit < 0 || hit >= len(AroundTheClockTargets) {
+		return 0, false
+	}
+	return AroundTheClockTargets[hit], true
+}
@@ -0,0 +1,76 @@
+package entities
+
+import "github.com/google/uuid"
+
+// Bobs27StartScore is the score each player starts a game of Bob's 27 on
+const Bobs27StartScore = 27
+
+// Bobs27Rounds is the number of rounds in Bob's 27: the doubles from 1 to 20
+// and then the bull
+const Bobs27Rounds = 21
+
+// Bobs27Rules are the rules of Bob's 27, a doubles training game. Round 1 is
+// played on double 1, round 2 on double 2 and so on up to the bull. Each hit
+// adds the double's value; a visit without a hit takes it off. A player who
+// drops to zero or below loses; otherwise the higher score after the bull
+// wins, and players level play on at the bull until a round separates them.
+type Bobs27Rules struct{}
+
+// Start puts both players on 27
+func (Bobs27Rules) Start(leg *Game) {
+	leg.Player1Score = Bobs27StartScore
+	leg.Player2Score = Bobs27StartScore
+}
+
+// Score adds the double's value for a hit, and takes it off after a visit's
+// last dart if none of the visit's darts hit it
+func (Bobs27Rules) Score(leg *Game, match *Match, throws []*Throw, throw *Throw) error {
+	target := Bobs27Target(legRound(throw.TurnNumber))
+	double := Dart{Segment: target, Multiplier: 2}
+
+	throw.Score = 0
+	if throw.Dart() == double {
+		throw.Score = double.Score()
+	}
+	score := leg.PlayerScore(match, throw.PlayerID) + throw.Score
+
+	if throw.EndsTurn() {
+		hit := false
+		for _, t := range turnThrows(throws, throw) {
+			hit = hit || t.Score > 0
+		}
+		if !hit {
+			score -= double.Score()
+		}
+	}
+
+	throw.RemainingScore = score
+	leg.setPlayerScore(match, throw.PlayerID, score)
+	return nil
+}
+
+// Winner returns the opponent of a thrower who drops to zero, or the leader
+// at the end of the last round or any extra round
+func (Bobs27Rules) Winner(leg *Game, match *Match, throws []*Throw, throw *Throw) *uuid.UUID {
+	if throw.RemainingScore <= 0 {
+		opponent, err := match.GetOpponent(throw.PlayerID)
+		if err != nil {
+			return nil
+		}
+		return opponent
+	}
+
+	if !endsRound(throw) || legRound(throw.TurnNumber) < Bobs27Rounds {
+		return nil
+	}
+	return roundLeader(leg, match)
+}
+
+// Bobs27Target returns the segment whose double a round of Bob's 27 is
+// played on
+func Bobs27Target(round int) int {
+	if round >= Bobs27Rounds {
+		return Bull
+	}
+	return round
+}
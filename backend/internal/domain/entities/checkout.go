@@ -0,0 +1,214 @@
+package entities
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Checkout is a suggested finishing route for a remaining score
+type Checkout struct {
+	Remaining int       `json:"remaining"`
+	DartsLeft int       `json:"darts_left"`
+	OutRule   CheckRule `json:"out_rule"`
+	Possible  bool      `json:"possible"`           // false for "no checkout" scores
+	Route     []Dart    `json:"route,omitempty"`    // darts to throw, finishing dart last
+	Notation  string    `json:"notation,omitempty"` // e.g. "T20 T20 BULL"
+}
+
+// doubleOutRoutes is the standard professional double-out checkout chart
+var doubleOutRoutes = map[int]string{
+	170: "T20 T20 BULL", 167: "T20 T19 BULL", 164: "T20 T18 BULL", 161: "T20 T17 BULL",
+	160: "T20 T20 D20", 158: "T20 T20 D19", 157: "T20 T19 D20", 156: "T20 T20 D18",
+	155: "T20 T19 D19", 154: "T20 T18 D20", 153: "T20 T19 D18", 152: "T20 T20 D16",
+	151: "T20 T17 D20", 150: "T20 T18 D18", 149: "T20 T19 D16", 148: "T20 T20 D14",
+	147: "T20 T17 D18", 146: "T20 T18 D16", 145: "T20 T15 D20", 144: "T20 T20 D12",
+	143: "T20 T17 D16", 142: "T20 T14 D20", 141: "T20 T19 D12", 140: "T20 T20 D10",
+	139: "T20 T13 D20", 138: "T20 T18 D12", 137: "T20 T19 D10", 136: "T20 T20 D8",
+	135: "T20 T17 D12", 134: "T20 T14 D16", 133: "T20 T19 D8", 132: "T20 T16 D12",
+	131: "T20 T13 D16", 130: "T20 T18 D8", 129: "T19 T16 D12", 128: "T18 T14 D16",
+	127: "T20 T17 D8", 126: "T19 T19 D6", 125: "25 T20 D20", 124: "T20 T16 D8",
+	123: "T19 T16 D9", 122: "T18 T20 D4", 121: "T20 T11 D14", 120: "T20 20 D20",
+	119: "T19 T12 D13", 118: "T20 18 D20", 117: "T20 17 D20", 116: "T20 16 D20",
+	115: "T20 15 D20", 114: "T20 14 D20", 113: "T20 13 D20", 112: "T20 12 D20",
+	111: "T20 11 D20", 110: "T20 BULL", 109: "T20 9 D20", 108: "T20 16 D16",
+	107: "T19 BULL", 106: "T20 6 D20", 105: "T20 13 D16", 104: "T18 BULL",
+	103: "T19 6 D20", 102: "T20 10 D16", 101: "T17 BULL", 100: "T20 D20",
+	99: "T19 10 D16", 98: "T20 D19", 97: "T19 D20", 96: "T20 D18",
+	95: "T19 D19", 94: "T18 D20", 93: "T19 D18", 92: "T20 D16",
+	91: "T17 D20", 90: "T20 D15", 89: "T19 D16", 88: "T16 D20",
+	87: "T17 D18", 86: "T18 D16", 85: "T15 D20", 84: "T20 D12",
+	83: "T17 D16", 82: "BULL D16", 81: "T19 D12", 80: "T20 D10",
+	79: "T19 D11", 78: "T18 D12", 77: "T19 D10", 76: "T20 D8",
+	75: "T17 D12", 74: "T14 D16", 73: "T19 D8", 72: "T16 D12",
+	71: "T13 D16", 70: "T10 D20", 69: "T15 D12", 68: "T16 D10",
+	67: "T17 D8", 66: "T10 D18", 65: "T11 D16", 64: "T16 D8",
+	63: "T13 D12", 62: "T10 D16", 61: "T15 D8", 60: "20 D20",
+	59: "19 D20", 58: "18 D20", 57: "17 D20", 56: "16 D20",
+	55: "15 D20", 54: "14 D20", 53: "13 D20", 52: "12 D20",
+	51: "11 D20", 50: "BULL", 49: "9 D20", 48: "16 D16",
+	47: "15 D16", 46: "6 D20", 45: "13 D16", 44: "12 D16",
+	43: "3 D20", 42: "10 D16", 41: "9 D16", 40: "D20",
+	39: "7 D16", 38: "D19", 37: "5 D16", 36: "D18",
+	35: "3 D16", 34: "D17", 33: "1 D16", 32: "D16",
+	31: "15 D8", 30: "D15", 29: "13 D8", 28: "D14",
+	27: "11 D8", 26: "D13", 25: "9 D8", 24: "D12",
+	23: "7 D8", 22: "D11", 21: "5 D8", 20: "D10",
+	19: "3 D8", 18: "D9", 17: "1 D8", 16: "D8",
+	15: "7 D4", 14: "D7", 13: "5 D4", 12: "D6",
+	11: "3 D4", 10: "D5", 9: "1 D4", 8: "D4",
+	7: "3 D2", 6: "D3", 5: "1 D2", 4: "D2",
+	3: "1 D1", 2: "D1",
+}
+
+// preferredDoubles is the order finishing doubles are tried in when a route
+// is not on the chart
+var preferredDoubles = []int{20, 16, 8, 18, 12, 10, 4, 19, 17, 15, 14, 13, 11, 9, 7, 6, 5, 3, 2, 1, Bull}
+
+// SuggestCheckout returns the preferred route to finish the remaining score
+// with the darts left in the visit under the out-rule. Double-out routes
+// follow the standard chart; other rules, and visits with fewer darts left
+// than the chart route needs, use the fewest darts, setting up with the
+// biggest trebles and finishing on the favourite doubles.
+func SuggestCheckout(remaining, dartsLeft int, outRule CheckRule) *Checkout {
+	checkout := &Checkout{Remaining: remaining, DartsLeft: dartsLeft, OutRule: outRule}
+
+	var route []Dart
+	if outRule == CheckRuleDouble {
+		if chart, ok := doubleOutRoutes[remaining]; ok {
+			route = parseRoute(chart)
+		}
+	}
+	if route == nil || len(route) > dartsLeft {
+		route = nil
+		for darts := 1; darts <= dartsLeft && route == nil; darts++ {
+			route = findRoute(remaining, darts, outRule)
+		}
+	}
+
+	if route != nil {
+		checkout.Possible = true
+		checkout.Route = route
+		notation := make([]string, len(route))
+		for i, dart := range route {
+			notation[i] = dart.String()
+		}
+		checkout.Notation = strings.Join(notation, " ")
+	}
+	return checkout
+}
+
+// CanCheckout returns true if the remaining score can be finished with at
+// most the given number of darts under the out-rule
+func CanCheckout(remaining, darts int, outRule CheckRule) bool {
+	for n := 1; n <= darts; n++ {
+		if findRoute(remaining, n, outRule) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the dart in checkout notation: T20, D16, 5, 25 for the
+// outer bull, BULL for the inner bull and MISS
+func (d Dart) String() string {
+	switch {
+	case d.Segment == 0:
+		return "MISS"
+	case d.Segment == Bull && d.Multiplier == 2:
+		return "BULL"
+	case d.Multiplier == 2:
+		return "D" + strconv.Itoa(d.Segment)
+	case d.Multiplier == 3:
+		return "T" + strconv.Itoa(d.Segment)
+	}
+	return strconv.Itoa(d.Segment)
+}
+
+// parseRoute reads a chart route written in checkout notation
+func parseRoute(route string) []Dart {
+	fields := strings.Fields(route)
+	darts := make([]Dart, len(fields))
+	for i, field := range fields {
+		switch {
+		case field == "BULL":
+			darts[i] = Dart{Segment: Bull, Multiplier: 2}
+		case field[0] == 'D':
+			segment, _ := strconv.Atoi(field[1:])
+			darts[i] = Dart{Segment: segment, Multiplier: 2}
+		case field[0] == 'T':
+			segment, _ := strconv.Atoi(field[1:])
+			darts[i] = Dart{Segment: segment, Multiplier: 3}
+		default:
+			segment, _ := strconv.Atoi(field)
+			darts[i] = Dart{Segment: segment, Multiplier: 1}
+		}
+	}
+	return darts
+}
+
+// findRoute searches for a route of exactly the given number of darts,
+// trying setup darts from the biggest trebles down and finishing darts in
+// order of preference
+func findRoute(remaining, darts int, outRule CheckRule) []Dart {
+	if darts == 1 {
+		for _, dart := range finishingDarts(outRule) {
+			if dart.Score() == remaining {
+				return []Dart{dart}
+			}
+		}
+		return nil
+	}
+
+	for _, dart := range setupDarts() {
+		left := remaining - dart.Score()
+		if left < 1 {
+			continue
+		}
+		if rest := findRoute(left, darts-1, outRule); rest != nil {
+			return append([]Dart{dart}, rest...)
+		}
+	}
+	return nil
+}
+
+// setupDarts lists the darts used to leave a finish, most preferred first
+func setupDarts() []Dart {
+	darts := make([]Dart, 0, 62)
+	for segment := 20; segment >= 1; segment-- {
+		darts = append(darts, Dart{Segment: segment, Multiplier: 3})
+	}
+	darts = append(darts, Dart{Segment: Bull, Multiplier: 2}, Dart{Segment: Bull, Multiplier: 1})
+	for segment := 20; segment >= 1; segment-- {
+		darts = append(darts, Dart{Segment: segment, Multiplier: 1})
+	}
+	for segment := 20; segment >= 1; segment-- {
+		darts = append(darts, Dart{Segment: segment, Multiplier: 2})
+	}
+	return darts
+}
+
+// finishingDarts lists the darts that may finish a leg under the out-rule,
+// most preferred first
+func finishingDarts(outRule CheckRule) []Dart {
+	var darts []Dart
+	for _, segment := range preferredDoubles {
+		darts = append(darts, Dart{Segment: segment, Multiplier: 2})
+	}
+	if outRule == CheckRuleDouble {
+		return darts
+	}
+
+	for segment := 20; segment >= 1; segment-- {
+		darts = append(darts, Dart{Segment: segment, Multiplier: 3})
+	}
+	if outRule == CheckRuleMaster {
+		return darts
+	}
+
+	// Straight out: singles are the easiest finish
+	singles := []Dart{{Segment: Bull, Multiplier: 1}}
+	for segment := 20; segment >= 1; segment-- {
+		singles = append(singles, Dart{Segment: segment, Multiplier: 1})
+	}
+	return append(singles, darts...)
+}
@@ -0,0 +1,133 @@
+package entities
+
+import "testing"
+
+var checkRules = []CheckRule{CheckRuleStraight, CheckRuleDouble, CheckRuleMaster}
+
+func routeScore(route []Dart) int {
+	total := 0
+	for _, dart := range route {
+		total += dart.Score()
+	}
+	return total
+}
+
+func TestDoubleOutChart(t *testing.T) {
+	for remaining, chart := range doubleOutRoutes {
+		route := parseRoute(chart)
+		if len(route) == 0 || len(route) > DartsPerTurn {
+			t.Errorf("%d: route %q has %d darts", remaining, chart, len(route))
+			continue
+		}
+		if total := routeScore(route); total != remaining {
+			t.Errorf("%d: route %q scores %d", remaining, chart, total)
+		}
+		if last := route[len(route)-1]; !last.IsDouble() {
+			t.Errorf("%d: route %q does not finish on a double", remaining, chart)
+		}
+	}
+}
+
+func TestCanCheckout(t *testing.T) {
+	tests := []struct {
+		name      string
+		remaining int
+		darts     int
+		outRule   CheckRule
+		want      bool
+	}{
+		{"170 is the biggest double-out finish", 170, 3, CheckRuleDouble, true},
+		{"bogey 169", 169, 3, CheckRuleDouble, false},
+		{"bogey 168", 168, 3, CheckRuleDouble, false},
+		{"bogey 166", 166, 3, CheckRuleDouble, false},
+		{"bogey 165", 165, 3, CheckRuleDouble, false},
+		{"bogey 163", 163, 3, CheckRuleDouble, false},
+		{"bogey 162", 162, 3, CheckRuleDouble, false},
+		{"bogey 159", 159, 3, CheckRuleDouble, false},
+		{"171 is out of reach", 171, 3, CheckRuleDouble, false},
+		{"1 cannot be finished on a double", 1, 3, CheckRuleDouble, false},
+
+		{"master out 180 on three trebles", 180, 3, CheckRuleMaster, true},
+		{"master out 179 is a bogey", 179, 3, CheckRuleMaster, false},
+		{"master out 3 on T1", 3, 1, CheckRuleMaster, true},
+		{"master out 57 on T19", 57, 1, CheckRuleMaster, true},
+		{"master out 25 needs two darts", 25, 1, CheckRuleMaster, false},
+		{"double out 57 needs two darts", 57, 1, CheckRuleDouble, false},
+		{"straight out 1", 1, 1, CheckRuleStraight, true},
+		{"straight out 25 on the outer bull", 25, 1, CheckRuleStraight, true},
+
+		{"one dart: D20", 40, 1, CheckRuleDouble, true},
+		{"one dart: bull", 50, 1, CheckRuleDouble, true},
+		{"one dart: 41 is not a double", 41, 1, CheckRuleDouble, false},
+		{"two darts: 110 on T20 BULL", 110, 2, CheckRuleDouble, true},
+		{"two darts: 100 on T20 D20", 100, 2, CheckRuleDouble, true},
+		{"two darts: 99 needs three", 99, 2, CheckRuleDouble, false},
+		{"three darts: 99", 99, 3, CheckRuleDouble, true},
+		{"two darts: 170 needs three", 170, 2, CheckRuleDouble, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanCheckout(tt.remaining, tt.darts, tt.outRule); got != tt.want {
+				t.Errorf("CanCheckout(%d, %d, %s) = %v, want %v", tt.remaining, tt.darts, tt.outRule, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuggestCheckout(t *testing.T) {
+	tests := []struct {
+		remaining int
+		dartsLeft int
+		outRule   CheckRule
+		want      string
+	}{
+		{170, 3, CheckRuleDouble, "T20 T20 BULL"},
+		{100, 3, CheckRuleDouble, "T20 D20"},
+		{40, 1, CheckRuleDouble, "D20"},
+		{104, 2, CheckRuleDouble, "T18 BULL"},
+		{180, 3, CheckRuleMaster, "T20 T20 T20"},
+		{60, 1, CheckRuleMaster, "T20"},
+		{20, 1, CheckRuleStraight, "20"},
+		{169, 3, CheckRuleDouble, ""},
+		{99, 2, CheckRuleDouble, ""},
+		{59, 1, CheckRuleStraight, ""},
+	}
+
+	for _, tt := range tests {
+		checkout := SuggestCheckout(tt.remaining, tt.dartsLeft, tt.outRule)
+		if checkout.Notation != tt.want || checkout.Possible != (tt.want != "") {
+			t.Errorf("SuggestCheckout(%d, %d, %s) = %q (possible %v), want %q",
+				tt.remaining, tt.dartsLeft, tt.outRule, checkout.Notation, checkout.Possible, tt.want)
+		}
+	}
+}
+
+// Every suggestion must be a real finish within the darts left, and there
+// must be one exactly when CanCheckout says so
+func TestSuggestCheckoutRoutesFinish(t *testing.T) {
+	for _, outRule := range checkRules {
+		for darts := 1; darts <= DartsPerTurn; darts++ {
+			for remaining := 1; remaining <= MaxVisit+1; remaining++ {
+				checkout := SuggestCheckout(remaining, darts, outRule)
+				if checkout.Possible != CanCheckout(remaining, darts, outRule) {
+					t.Errorf("%d in %d darts %s: possible %v disagrees with CanCheckout", remaining, darts, outRule, checkout.Possible)
+					continue
+				}
+				if !checkout.Possible {
+					continue
+				}
+				route := checkout.Route
+				if len(route) > darts {
+					t.Errorf("%d in %d darts %s: route %s is too long", remaining, darts, outRule, checkout.Notation)
+				}
+				if routeScore(route) != remaining {
+					t.Errorf("%d in %d darts %s: route %s scores %d", remaining, darts, outRule, checkout.Notation, routeScore(route))
+				}
+				if !outRule.Allows(route[len(route)-1]) {
+					t.Errorf("%d in %d darts %s: route %s ends on a dart the out-rule does not allow", remaining, darts, outRule, checkout.Notation)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package entities
+
+import "github.com/google/uuid"
+
+// CricketTargets are the numbers in play in cricket, in scoreboard order
+var CricketTargets = []int{20, 19, 18, 17, 16, 15, Bull}
+
+// CricketMarksToClose is the number of marks that closes a target
+const CricketMarksToClose = 3
+
+// CricketMarks counts a player's marks on each cricket target
+type CricketMarks map[int]int
+
+// NewCricketMarks returns a mark sheet with every target open
+func NewCricketMarks() CricketMarks {
+	marks := make(CricketMarks, len(CricketTargets))
+	for _, target := range CricketTargets {
+		marks[target] = 0
+	}
+	return marks
+}
+
+// IsClosed returns true if the target has been closed
+func (m CricketMarks) IsClosed(target int) bool {
+	return m[target] >= CricketMarksToClose
+}
+
+// AllClosed returns true if every target has been closed
+func (m CricketMarks) AllClosed() bool {
+	for _, target := range CricketTargets {
+		if !m.IsClosed(target) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCricketTarget returns true if the segment is in play in cricket
+func isCricketTarget(segment int) bool {
+	return segment == Bull || (segment >= 15 && segment <= 20)
+}
+
+// CricketRules are cricket's rules; in cut-throat, points count against the
+// opponent
+type CricketRules struct {
+	CutThroat bool
+}
+
+// Start opens every target for both players with no points
+func (r CricketRules) Start(leg *Game) {
+	leg.Player1Score = 0
+	leg.Player2Score = 0
+	leg.Player1Marks = NewCricketMarks()
+	leg.Player2Marks = NewCricketMarks()
+}
+
+// Score counts each single, double or treble on a target as one, two or
+// three marks; three marks close it. Marks on a closed target score its value
+// while the opponent still has it open, for the thrower or, in cut-throat,
+// against the opponent.
+func (r CricketRules) Score(leg *Game, match *Match, throws []*Throw, throw *Throw) error {
+	opponent, err := match.GetOpponent(throw.PlayerID)
+	if err != nil {
+		return err
+	}
+	opponentID := *opponent
+
+	own, theirs := leg.cricketMarks(match, throw.PlayerID), leg.cricketMarks(match, opponentID)
+	points := 0
+	if isCricketTarget(throw.Segment) {
+		for i := 0; i < throw.Multiplier; i++ {
+			if !own.IsClosed(throw.Segment) {
+				own[throw.Segment]++
+				continue
+			}
+			if !theirs.IsClosed(throw.Segment) {
+				points += throw.Segment
+			}
+		}
+	}
+
+	throw.Score = points
+	if r.CutThroat {
+		leg.setPlayerScore(match, opponentID, leg.PlayerScore(match, opponentID)+points)
+	} else {
+		leg.setPlayerScore(match, throw.PlayerID, leg.PlayerScore(match, throw.PlayerID)+points)
+	}
+	throw.RemainingScore = leg.PlayerScore(match, throw.PlayerID)
+	return nil
+}
+
+// Winner returns the thrower once they have closed every target while ahead
+// or level on points (or, in cut-throat, level or behind)
+func (r CricketRules) Winner(leg *Game, match *Match, throws []*Throw, throw *Throw) *uuid.UUID {
+	opponent, err := match.GetOpponent(throw.PlayerID)
+	if err != nil {
+		return nil
+	}
+
+	score, opponentScore := leg.PlayerScore(match, throw.PlayerID), leg.PlayerScore(match, *opponent)
+	ahead := score >= opponentScore
+	if r.CutThroat {
+		ahead = score <= opponentScore
+	}
+	if !leg.cricketMarks(match, throw.PlayerID).AllClosed() || !ahead {
+		return nil
+	}
+	return &throw.PlayerID
+}
+
+// cricketMarks returns a match participant's mark sheet, creating it on the
+// leg's first dart
+func (g *Game) cricketMarks(match *Match, playerID uuid.UUID) CricketMarks {
+	if match.Player1ID != nil && *match.Player1ID == playerID {
+		if g.Player1Marks == nil {
+			g.Player1Marks = NewCricketMarks()
+		}
+		return g.Player1Marks
+	}
+	if g.Player2Marks == nil {
+		g.Player2Marks = NewCricketMarks()
+	}
+	return g.Player2Marks
+}
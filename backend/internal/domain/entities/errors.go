@@ -6,30 +6,83 @@ import "errors"
 var (
 	ErrInvalidPlayerName = errors.New("player name cannot be empty")
 	ErrPlayerNotFound    = errors.New("player not found")
+	ErrInvalidRating     = errors.New("player rating cannot be negative")
 )
 
 // League errors
 var (
-	ErrInvalidLeagueName       = errors.New("league name cannot be empty")
-	ErrLeagueNotFound          = errors.New("league not found")
-	ErrLeagueAlreadyStarted    = errors.New("league has already started")
-	ErrLeagueAlreadyCompleted  = errors.New("league is already completed")
+	ErrInvalidLeagueName            = errors.New("league name cannot be empty")
+	ErrLeagueNotFound               = errors.New("league not found")
+	ErrLeagueAlreadyStarted         = errors.New("league has already started")
+	ErrLeagueAlreadyCompleted       = errors.New("league is already completed")
+	ErrInvalidStandingsTiebreaker   = errors.New("unknown standings tiebreaker")
+	ErrDuplicateStandingsTiebreaker = errors.New("standings tiebreaker listed more than once")
+	ErrInvalidPointsSchedule        = errors.New("points schedule keys must be positions or elimination rounds with non-negative points")
 )
 
 // Tournament errors
 var (
 	ErrInvalidTournamentName      = errors.New("tournament name cannot be empty")
+	ErrInvalidTournamentType      = errors.New("unsupported tournament type")
 	ErrTournamentNotFound         = errors.New("tournament not found")
 	ErrTournamentAlreadyStarted   = errors.New("tournament has already started")
 	ErrTournamentAlreadyCompleted = errors.New("tournament is already completed")
+	ErrNotEnoughPlayers           = errors.New("tournament requires at least two players")
+	ErrInvalidTiebreaker          = errors.New("unknown table tiebreaker")
+	ErrDuplicateTiebreaker        = errors.New("table tiebreaker listed more than once")
+	ErrInvalidGroupSettings       = errors.New("group settings do not fit the number of players")
+	ErrInvalidSwissRounds         = errors.New("swiss round count does not fit the number of players")
+	ErrTournamentNotInProgress    = errors.New("tournament is not in progress")
+	ErrRoundsNotSupported         = errors.New("tournament format does not generate rounds on demand")
+	ErrRoundNotComplete           = errors.New("current round still has unfinished matches")
+	ErrNoMoreRounds               = errors.New("all rounds have already been played")
+	ErrInvalidSeedingMethod       = errors.New("unsupported seeding method")
+	ErrInvalidSeeds               = errors.New("seeds must be unique, start at 1 and only name tournament players")
+	ErrInvalidGameType            = errors.New("unsupported game type")
+	ErrInvalidX01Rules            = errors.New("x01 rules need an x01 game, a starting score above 1 and known in- and out-rules")
+	ErrInvalidMatchFormat         = errors.New("match format must be an odd best-of, or fixed legs in a round-robin or Swiss tournament")
 )
 
 // Match errors
 var (
-	ErrMatchNotFound        = errors.New("match not found")
-	ErrMatchAlreadyStarted  = errors.New("match has already started")
-	ErrMatchNotInProgress   = errors.New("match is not in progress")
-	ErrMatchMissingPlayers  = errors.New("match requires both players to be set")
-	ErrPlayerNotInMatch     = errors.New("player is not participating in this match")
-	ErrInvalidWinner        = errors.New("winner must be one of the match participants")
-)
\ No newline at end of file
+	ErrMatchNotFound       = errors.New("match not found")
+	ErrMatchAlreadyStarted = errors.New("match has already started")
+	ErrMatchNotInProgress  = errors.New("match is not in progress")
+	ErrMatchMissingPlayers = errors.New("match requires both players to be set")
+	ErrPlayerNotInMatch    = errors.New("player is not participating in this match")
+	ErrInvalidWinner       = errors.New("winner must be one of the match participants")
+	ErrInvalidMatchSlot    = errors.New("match slot must be 1 or 2")
+	ErrInvalidMatchOutcome = errors.New("unsupported match outcome")
+	ErrInvalidScore        = errors.New("score is not possible in this match format")
+	ErrScoreFromLegs       = errors.New("match score is derived from its legs")
+	ErrInvalidThrowOff     = errors.New("throw-off must be a bull-off or coin toss")
+	ErrThrowOffTooLate     = errors.New("throw-off must be recorded before the first leg")
+	ErrInvalidHandicap     = errors.New("handicap start score must be above 1 and legs awarded cannot decide a set or the match")
+	ErrHandicapTooLate     = errors.New("handicap must be set before the first leg")
+	ErrHandicapNotX01      = errors.New("start score handicaps only apply to x01 games")
+	ErrHandicapNoLeague    = errors.New("handicaps can only be computed for league matches")
+)
+
+// Statistics errors
+var (
+	ErrStatsNotFound = errors.New("no statistics recorded for this player")
+	ErrInvalidMetric = errors.New("leaderboard metric must be average, checkout, 180s or best_finish")
+	ErrSamePlayer    = errors.New("head-to-head needs two different players")
+)
+
+// Leg errors
+var (
+	ErrLegNotFound        = errors.New("leg not found")
+	ErrLegInProgress      = errors.New("a leg of this match is already in progress")
+	ErrLegNotInProgress   = errors.New("leg is not in progress")
+	ErrInvalidDart        = errors.New("dart must hit 1-20 with a multiplier of 1-3, the bull as a single or double, or miss")
+	ErrGameNotScored      = errors.New("throw-by-throw scoring is not available for this game type")
+	ErrLegClosed          = errors.New("only the latest leg of an ongoing match can be corrected")
+	ErrNothingToUndo      = errors.New("no darts left to undo")
+	ErrVisitNotFound      = errors.New("visit not found in this leg")
+	ErrInvalidCorrection  = errors.New("correction would leave darts thrown after the leg was won")
+	ErrVisitInProgress    = errors.New("the current visit is being scored dart by dart")
+	ErrImpossibleVisit    = errors.New("visit total cannot be scored with three darts")
+	ErrImpossibleCheckout = errors.New("checkout cannot be finished with the darts used under the out-rule")
+	ErrVisitNeedsDarts    = errors.New("the opening visit must be scored dart by dart under a double- or master-in")
+)
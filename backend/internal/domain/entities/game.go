@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GameStatus string
+
+const (
+	GameStatusInProgress GameStatus = "in_progress"
+	GameStatusCompleted  GameStatus = "completed"
+)
+
+// Game is a single leg of a match
+type Game struct {
+	ID             uuid.UUID  `json:"id"`
+	MatchID        uuid.UUID  `json:"match_id"`
+	SetNumber      int        `json:"set_number"`
+	LegNumber      int        `json:"leg_number"`
+	FirstThrowerID uuid.UUID  `json:"first_thrower_id"`
+	Player1Score   int        `json:"player1_score"` // remaining score in X01, otherwise the game's points, hits or lives
+	Player2Score   int        `json:"player2_score"`
+	WinnerID       *uuid.UUID `json:"winner_id,omitempty"`
+	Status         GameStatus `json:"status"`
+	CreatedAt      time.Time  `json:"created_at"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+
+	// Cricket legs only; the scores above are then points
+	Player1Marks CricketMarks `json:"player1_marks,omitempty"`
+	Player2Marks CricketMarks `json:"player2_marks,omitempty"`
+
+	// Killer legs only; the scores above are then lives
+	Player1Number int  `json:"player1_number,omitempty"`
+	Player2Number int  `json:"player2_number,omitempty"`
+	Player1Killer bool `json:"player1_killer,omitempty"`
+	Player2Killer bool `json:"player2_killer,omitempty"`
+}
+
+// NewGame starts a leg of a match in the starting state of its game
+func NewGame(matchID uuid.UUID, setNumber, legNumber int, firstThrowerID uuid.UUID, rules GameRules) *Game {
+	game := &Game{
+		ID:             uuid.New(),
+		MatchID:        matchID,
+		SetNumber:      setNumber,
+		LegNumber:      legNumber,
+		FirstThrowerID: firstThrowerID,
+		Status:         GameStatusInProgress,
+		CreatedAt:      time.Now(),
+	}
+	rules.Start(game)
+	return game
+}
+
+// Complete finishes the leg with the given winner
+func (g *Game) Complete(winnerID uuid.UUID) error {
+	if g.Status != GameStatusInProgress {
+		return ErrLegNotInProgress
+	}
+
+	g.WinnerID = &winnerID
+	g.Status = GameStatusCompleted
+	now := time.Now()
+	g.CompletedAt = &now
+
+	return nil
+}
+
+// Reset puts the leg back to its first dart so it can be replayed from its
+// scoring log
+func (g *Game) Reset(rules GameRules) {
+	g.WinnerID = nil
+	g.Status = GameStatusInProgress
+	g.CompletedAt = nil
+	g.Player1Marks, g.Player2Marks = nil, nil
+	rules.Start(g)
+}
+
+// IsCompleted returns true if the leg has a winner
+func (g *Game) IsCompleted() bool {
+	return g.Status == GameStatusCompleted && g.WinnerID != nil
+}
+
+// PlayerScore returns a match participant's score in the leg: the score left
+// in X01, otherwise the game's points, targets hit or lives
+func (g *Game) PlayerScore(match *Match, playerID uuid.UUID) int {
+	if match.Player1ID != nil && *match.Player1ID == playerID {
+		return g.Player1Score
+	}
+	return g.Player2Score
+}
+
+// setPlayerScore updates a match participant's score in the leg
+func (g *Game) setPlayerScore(match *Match, playerID uuid.UUID, score int) {
+	if match.Player1ID != nil && *match.Player1ID == playerID {
+		g.Player1Score = score
+		return
+	}
+	g.Player2Score = score
+}
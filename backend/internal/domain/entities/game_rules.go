@@ -0,0 +1,98 @@
+package entities
+
+import "github.com/google/uuid"
+
+// GameRules are the rules of a game played leg by leg: the state a leg starts
+// in, what each dart does to it and when it has been won
+type GameRules interface {
+	// Start puts a leg on its starting scores
+	Start(leg *Game)
+	// Score applies the throw's dart to the leg, setting what it scored and
+	// the thrower's score after it. throws are the leg's earlier throws.
+	Score(leg *Game, match *Match, throws []*Throw, throw *Throw) error
+	// Winner returns who has won the leg after the throw, if anyone
+	Winner(leg *Game, match *Match, throws []*Throw, throw *Throw) *uuid.UUID
+}
+
+// NewGameRules returns the rules of a game type; X01 games are played under
+// the given X01 rules
+func NewGameRules(gameType GameType, x01 X01Rules) (GameRules, error) {
+	switch gameType {
+	case GameType501, GameType301:
+		return x01, nil
+	case GameTypeCricket, GameTypeCricketCutThroat:
+		return CricketRules{CutThroat: gameType == GameTypeCricketCutThroat}, nil
+	case GameTypeAroundTheClock:
+		return AroundTheClockRules{}, nil
+	case GameTypeShanghai:
+		return ShanghaiRules{}, nil
+	case GameTypeKiller:
+		return KillerRules{}, nil
+	case GameTypeBobs27:
+		return Bobs27Rules{}, nil
+	}
+	return nil, ErrGameNotScored
+}
+
+// Throw scores the next dart of the leg under the game's rules. The thrower
+// is worked out from the turns so far; a winning dart completes the leg.
+func (g *Game) Throw(rules GameRules, match *Match, throws []*Throw, dart Dart) (*Throw, error) {
+	if g.Status != GameStatusInProgress {
+		return nil, ErrLegNotInProgress
+	}
+	if err := dart.Validate(); err != nil {
+		return nil, err
+	}
+
+	playerID, turn, number, err := g.NextDart(match, throws)
+	if err != nil {
+		return nil, err
+	}
+
+	throw := NewThrow(g.ID, playerID, turn, number, dart)
+	if err := rules.Score(g, match, throws, throw); err != nil {
+		return nil, err
+	}
+	if winnerID := rules.Winner(g, match, throws, throw); winnerID != nil {
+		if err := g.Complete(*winnerID); err != nil {
+			return nil, err
+		}
+	}
+	return throw, nil
+}
+
+// legRound returns the round a turn belongs to; both players throw once a
+// round
+func legRound(turn int) int {
+	return (turn + 1) / 2
+}
+
+// endsRound returns true if the throw is the last dart of a round
+func endsRound(throw *Throw) bool {
+	return throw.TurnNumber%2 == 0 && throw.EndsTurn()
+}
+
+// turnThrows returns the earlier throws of the throw's turn followed by the
+// throw itself
+func turnThrows(throws []*Throw, throw *Throw) []*Throw {
+	var turn []*Throw
+	for _, earlier := range throws {
+		if earlier.TurnNumber == throw.TurnNumber {
+			turn = append(turn, earlier)
+		}
+	}
+	return append(turn, throw)
+}
+
+// roundLeader returns who is ahead on points, or nil if the players are level
+func roundLeader(leg *Game, match *Match) *uuid.UUID {
+	switch {
+	case match.Player1ID == nil || match.Player2ID == nil:
+		return nil
+	case leg.Player1Score > leg.Player2Score:
+		return match.Player1ID
+	case leg.Player2Score > leg.Player1Score:
+		return match.Player2ID
+	}
+	return nil
+}
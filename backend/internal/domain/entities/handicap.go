@@ -0,0 +1,109 @@
+package entities
+
+import "sort"
+
+// Handicap is a player's head start in a match: a lower score to start each
+// X01 leg on, legs already awarded, or both. The zero value is no handicap.
+type Handicap struct {
+	StartScore  int `json:"start_score,omitempty"`  // 0 plays off the game's usual start
+	LegsAwarded int `json:"legs_awarded,omitempty"` // counted in the first set when playing sets
+}
+
+// HandicapMethod is which kind of head start a handicap table gives
+type HandicapMethod string
+
+const (
+	HandicapStartScore HandicapMethod = "start_score"
+	HandicapLegs       HandicapMethod = "legs"
+)
+
+// IsValid returns true if the handicap method is supported
+func (m HandicapMethod) IsValid() bool {
+	return m == HandicapStartScore || m == HandicapLegs
+}
+
+// HandicapBand is a row of a handicap table: players averaging at least
+// MinAverage are given the band's start score reduction or legs
+type HandicapBand struct {
+	MinAverage float64 `json:"min_average"`
+	Reduction  int     `json:"reduction"`
+	Legs       int     `json:"legs"`
+}
+
+// HandicapTable maps three-dart averages to head starts
+type HandicapTable []HandicapBand
+
+// DefaultHandicapTable gives 60 points or a leg for every ten points of
+// average below 60, up to 240 points or two legs
+var DefaultHandicapTable = HandicapTable{
+	{MinAverage: 60, Reduction: 0, Legs: 0},
+	{MinAverage: 50, Reduction: 60, Legs: 0},
+	{MinAverage: 40, Reduction: 120, Legs: 1},
+	{MinAverage: 30, Reduction: 180, Legs: 1},
+	{MinAverage: 0, Reduction: 240, Legs: 2},
+}
+
+// Band returns the row of the table a player with the given average falls in
+func (t HandicapTable) Band(average float64) HandicapBand {
+	bands := append(HandicapTable(nil), t...)
+	sort.Slice(bands, func(i, j int) bool { return bands[i].MinAverage > bands[j].MinAverage })
+	for _, band := range bands {
+		if average >= band.MinAverage {
+			return band
+		}
+	}
+	return HandicapBand{}
+}
+
+// Handicaps works out both players' handicaps from their averages. Only the
+// weaker player gets a head start: the difference between the two players'
+// bands, taken off the start score or given as legs. A start score never
+// drops below 2.
+func (t HandicapTable) Handicaps(average1, average2 float64, method HandicapMethod, startScore int) (Handicap, Handicap) {
+	band1, band2 := t.Band(average1), t.Band(average2)
+
+	var handicap1, handicap2 Handicap
+	switch method {
+	case HandicapStartScore:
+		handicap1.StartScore = handicapStartScore(startScore, band1.Reduction-band2.Reduction)
+		handicap2.StartScore = handicapStartScore(startScore, band2.Reduction-band1.Reduction)
+	case HandicapLegs:
+		handicap1.LegsAwarded = max(band1.Legs-band2.Legs, 0)
+		handicap2.LegsAwarded = max(band2.Legs-band1.Legs, 0)
+	}
+	return handicap1, handicap2
+}
+
+// handicapStartScore takes a reduction off the start score, or returns 0 for
+// no handicap
+func handicapStartScore(startScore, reduction int) int {
+	if reduction <= 0 {
+		return 0
+	}
+	return max(startScore-reduction, 2)
+}
+
+// Validate returns ErrInvalidHandicap if the handicap cannot be played:
+// a start score must be above 1, and legs awarded must leave a leg still to
+// win in the set (or, without sets, the match). setLength is zero when the
+// match is not played in sets.
+func (h Handicap) Validate(length, setLength MatchLength) error {
+	if h.StartScore < 0 || h.StartScore == 1 || h.LegsAwarded < 0 {
+		return ErrInvalidHandicap
+	}
+	if setLength.Units > 0 {
+		length = setLength
+	}
+
+	switch length.Format {
+	case MatchFormatBestOf:
+		if h.LegsAwarded >= length.WinningScore() {
+			return ErrInvalidHandicap
+		}
+	case MatchFormatFixed:
+		if h.LegsAwarded >= length.Units {
+			return ErrInvalidHandicap
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package entities
+
+import "github.com/google/uuid"
+
+// KillerLives is the number of lives each player starts a game of Killer with
+const KillerLives = 3
+
+// KillerRules are the rules of Killer, a party game. Each player's first dart
+// into a number the opponent has not taken becomes their number. Hitting
+// your own double makes you a killer; a killer's darts in the opponent's
+// double take a life, and in their own double cost them one. The player who
+// loses their last life loses the leg. Each player's score is their lives.
+type KillerRules struct{}
+
+// Start gives both players their lives, with no numbers yet
+func (KillerRules) Start(leg *Game) {
+	leg.Player1Score = KillerLives
+	leg.Player2Score = KillerLives
+	leg.Player1Number, leg.Player2Number = 0, 0
+	leg.Player1Killer, leg.Player2Killer = false, false
+}
+
+// Score picks the thrower's number, makes them a killer or takes lives; the
+// throw scores the lives it took from the opponent
+func (KillerRules) Score(leg *Game, match *Match, throws []*Throw, throw *Throw) error {
+	opponent, err := match.GetOpponent(throw.PlayerID)
+	if err != nil {
+		return err
+	}
+	opponentID := *opponent
+
+	number, killer := leg.killerState(match, throw.PlayerID)
+	opponentNumber, _ := leg.killerState(match, opponentID)
+	dart := throw.Dart()
+
+	throw.Score = 0
+	switch {
+	case *number == 0:
+		if dart.Segment >= 1 && dart.Segment <= 20 && dart.Segment != *opponentNumber {
+			*number = dart.Segment
+		}
+	case !*killer:
+		*killer = dart.IsDouble() && dart.Segment == *number
+	case dart.IsDouble() && dart.Segment == *opponentNumber:
+		throw.Score = 1
+		leg.setPlayerScore(match, opponentID, leg.PlayerScore(match, opponentID)-1)
+	case dart.IsDouble() && dart.Segment == *number:
+		leg.setPlayerScore(match, throw.PlayerID, leg.PlayerScore(match, throw.PlayerID)-1)
+	}
+
+	throw.RemainingScore = leg.PlayerScore(match, throw.PlayerID)
+	return nil
+}
+
+// Winner returns whoever is left once a player has lost their last life
+func (KillerRules) Winner(leg *Game, match *Match, throws []*Throw, throw *Throw) *uuid.UUID {
+	opponent, err := match.GetOpponent(throw.PlayerID)
+	if err != nil {
+		return nil
+	}
+
+	switch {
+	case leg.PlayerScore(match, *opponent) <= 0:
+		return &throw.PlayerID
+	case leg.PlayerScore(match, throw.PlayerID) <= 0:
+		return opponent
+	}
+	return nil
+}
+
+// killerState returns a match participant's number and killer flag in a
+// Killer leg
+func (g *Game) killerState(match *Match, playerID uuid.UUID) (number *int, killer *bool) {
+	if match.Player1ID != nil && *match.Player1ID == playerID {
+		return &g.Player1Number, &g.Player1Killer
+	}
+	return &g.Player2Number, &g.Player2Killer
+}
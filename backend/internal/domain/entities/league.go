@@ -1,18 +1,34 @@
 package entities
 
 import (
-	"time"
 	"github.com/google/uuid"
+	"time"
 )
 
 type LeagueStatus string
+type StandingsTiebreaker string
 
 const (
 	LeagueStatusSetup     LeagueStatus = "setup"
 	LeagueStatusActive    LeagueStatus = "active"
 	LeagueStatusCompleted LeagueStatus = "completed"
+
+	StandingsTiebreakerTournamentsWon StandingsTiebreaker = "tournaments_won"
+	StandingsTiebreakerFinalsReached  StandingsTiebreaker = "finals_reached"
+	StandingsTiebreakerSemiFinals     StandingsTiebreaker = "semi_finals_reached"
+	StandingsTiebreakerHeadToHead     StandingsTiebreaker = "head_to_head"
+	StandingsTiebreakerLeagueAverage  StandingsTiebreaker = "league_average"
 )
 
+// DefaultStandingsTiebreakers is the order used to split players level on
+// league points
+var DefaultStandingsTiebreakers = []StandingsTiebreaker{
+	StandingsTiebreakerTournamentsWon,
+	StandingsTiebreakerFinalsReached,
+	StandingsTiebreakerHeadToHead,
+	StandingsTiebreakerLeagueAverage,
+}
+
 type League struct {
 	ID          uuid.UUID    `json:"id"`
 	Name        string       `json:"name"`
@@ -21,11 +37,19 @@ type League struct {
 	Status      LeagueStatus `json:"status"`
 
 	// Points system
-	PointsForWin       int `json:"points_for_win"`
-	PointsForRunnerUp  int `json:"points_for_runner_up"`
-	PointsForSemiFinal int `json:"points_for_semi_final"`
+	PointsForWin       int  `json:"points_for_win"`
+	PointsForRunnerUp  int  `json:"points_for_runner_up"`
+	PointsForSemiFinal int  `json:"points_for_semi_final"`
 	MaxPlayers         *int `json:"max_players,omitempty"`
 
+	// Configurable points; an empty schedule falls back to the three fields above
+	PointsSchedule      PointsSchedule `json:"points_schedule,omitempty"`
+	ParticipationPoints int            `json:"participation_points"`
+	PointsPerMatchWin   int            `json:"points_per_match_win"`
+
+	// Standings order after league points
+	StandingsTiebreakers []StandingsTiebreaker `json:"standings_tiebreakers"`
+
 	// Dates
 	StartDate *time.Time `json:"start_date,omitempty"`
 	EndDate   *time.Time `json:"end_date,omitempty"`
@@ -40,16 +64,18 @@ func NewLeague(name, description, season string) (*League, error) {
 	}
 
 	return &League{
-		ID:                 uuid.New(),
-		Name:               name,
-		Description:        stringPtr(description),
-		Season:             stringPtr(season),
-		Status:             LeagueStatusSetup,
-		PointsForWin:       3,
-		PointsForRunnerUp:  2,
-		PointsForSemiFinal: 1,
-		CreatedAt:          time.Now(),
-		UpdatedAt:          time.Now(),
+		ID:                   uuid.New(),
+		Name:                 name,
+		Description:          stringPtr(description),
+		Season:               stringPtr(season),
+		Status:               LeagueStatusSetup,
+		PointsForWin:         3,
+		PointsForRunnerUp:    2,
+		PointsForSemiFinal:   1,
+		PointsSchedule:       DefaultPointsSchedule(),
+		StandingsTiebreakers: DefaultStandingsTiebreakers,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
 	}, nil
 }
 
@@ -81,6 +107,77 @@ func (l *League) CompleteLeague() error {
 	return nil
 }
 
+// SetPointsSystem replaces the points schedule and bonus points. Points can
+// only change before the league starts, so every tournament is scored alike.
+func (l *League) SetPointsSystem(schedule PointsSchedule, participationPoints, pointsPerMatchWin int) error {
+	if l.Status != LeagueStatusSetup {
+		return ErrLeagueAlreadyStarted
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	if participationPoints < 0 || pointsPerMatchWin < 0 {
+		return ErrInvalidPointsSchedule
+	}
+
+	l.PointsSchedule = schedule
+	l.ParticipationPoints = participationPoints
+	l.PointsPerMatchWin = pointsPerMatchWin
+
+	// Keep the fixed three-tier fields in step for older clients
+	l.PointsForWin = schedule.PointsFor(1, RoundWinner)
+	l.PointsForRunnerUp = schedule.PointsFor(2, RoundFinal)
+	l.PointsForSemiFinal = schedule.PointsFor(3, RoundSemiFinal)
+	l.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// SetStandingsTiebreakers sets the order used to split players level on
+// league points
+func (l *League) SetStandingsTiebreakers(tiebreakers []StandingsTiebreaker) error {
+	seen := make(map[StandingsTiebreaker]bool, len(tiebreakers))
+	for _, tiebreaker := range tiebreakers {
+		if !tiebreaker.IsValid() {
+			return ErrInvalidStandingsTiebreaker
+		}
+		if seen[tiebreaker] {
+			return ErrDuplicateStandingsTiebreaker
+		}
+		seen[tiebreaker] = true
+	}
+
+	l.StandingsTiebreakers = tiebreakers
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+// TournamentPoints returns the league points a player earns from one
+// tournament: the points for their finishing position and the elimination
+// round they reached, plus participation and match-win bonuses
+func (l *League) TournamentPoints(position int, round string, matchWins int) int {
+	return l.PointsForPosition(position, round) + l.ParticipationPoints + matchWins*l.PointsPerMatchWin
+}
+
+// PointsForPosition returns the league points for a tournament finishing
+// position and the elimination round reached, "" outside a knockout bracket;
+// both losing semi-finalists earn the semi-final points
+func (l *League) PointsForPosition(position int, round string) int {
+	if len(l.PointsSchedule) > 0 {
+		return l.PointsSchedule.PointsFor(position, round)
+	}
+
+	switch round {
+	case RoundWinner:
+		return l.PointsForWin
+	case RoundFinal:
+		return l.PointsForRunnerUp
+	case RoundSemiFinal:
+		return l.PointsForSemiFinal
+	}
+	return 0
+}
+
 // IsActive returns true if the league is currently active
 func (l *League) IsActive() bool {
 	return l.Status == LeagueStatusActive
@@ -89,4 +186,14 @@ func (l *League) IsActive() bool {
 // CanAddTournaments returns true if tournaments can be added
 func (l *League) CanAddTournaments() bool {
 	return l.Status == LeagueStatusSetup || l.Status == LeagueStatusActive
-}
\ No newline at end of file
+}
+
+// IsValid returns true if the standings tiebreaker is supported
+func (tb StandingsTiebreaker) IsValid() bool {
+	switch tb {
+	case StandingsTiebreakerTournamentsWon, StandingsTiebreakerFinalsReached, StandingsTiebreakerSemiFinals,
+		StandingsTiebreakerHeadToHead, StandingsTiebreakerLeagueAverage:
+		return true
+	}
+	return false
+}
@@ -6,11 +6,35 @@ import (
 )
 
 type MatchStatus string
+type BracketSide string
+type MatchOutcome string
+type ThrowOffMethod string
 
 const (
 	MatchStatusPending    MatchStatus = "pending"
+	MatchStatusReady      MatchStatus = "ready"
 	MatchStatusInProgress MatchStatus = "in_progress"
 	MatchStatusCompleted  MatchStatus = "completed"
+
+	BracketSideWinners    BracketSide = "winners"
+	BracketSideLosers     BracketSide = "losers"
+	BracketSideGrandFinal BracketSide = "grand_final"
+	BracketSideGroup      BracketSide = "group"
+)
+
+// Match outcomes. Anything other than a played match is awarded without
+// (or before the end of) play and is left out of statistics.
+const (
+	MatchOutcomePlayed   MatchOutcome = "played"
+	MatchOutcomeWalkover MatchOutcome = "walkover" // opponent did not turn up
+	MatchOutcomeForfeit  MatchOutcome = "forfeit"  // opponent withdrew or was disqualified
+	MatchOutcomeRetired  MatchOutcome = "retired"  // opponent retired during the match
+)
+
+// Ways of deciding who throws first in a match's first leg
+const (
+	ThrowOffBullOff  ThrowOffMethod = "bull_off" // nearest the bull throws first
+	ThrowOffCoinToss ThrowOffMethod = "coin_toss"
 )
 
 type Match struct {
@@ -21,12 +45,42 @@ type Match struct {
 	Player1ID    *uuid.UUID  `json:"player1_id,omitempty"`
 	Player2ID    *uuid.UUID  `json:"player2_id,omitempty"`
 	Player1Score int         `json:"player1_score"`
-	Player2Score int         `json:"player2_score"`
+	Player2Score int         `json:"player2_score"` // sets won when playing sets, otherwise legs
 	WinnerID     *uuid.UUID  `json:"winner_id,omitempty"`
 	Status       MatchStatus `json:"status"`
+	IsBye        bool        `json:"is_bye"`
 	StartedAt    *time.Time  `json:"started_at,omitempty"`
 	CompletedAt  *time.Time  `json:"completed_at,omitempty"`
 	CreatedAt    time.Time   `json:"created_at"`
+
+	// Bracket progression
+	BracketSide        BracketSide `json:"bracket_side"`
+	GroupNumber        *int        `json:"group_number,omitempty"`
+	NextMatchID        *uuid.UUID  `json:"next_match_id,omitempty"`
+	NextMatchSlot      int         `json:"next_match_slot,omitempty"`
+	LoserNextMatchID   *uuid.UUID  `json:"loser_next_match_id,omitempty"`
+	LoserNextMatchSlot int         `json:"loser_next_match_slot,omitempty"`
+
+	// How a completed match was decided
+	Outcome       MatchOutcome `json:"outcome,omitempty"`
+	OutcomeReason *string      `json:"outcome_reason,omitempty"`
+
+	// Game played in a standalone match's legs; tournament matches play
+	// their tournament's game
+	GameType GameType `json:"game_type,omitempty"`
+
+	// Legs won in the current (or last finished) set; without sets these
+	// follow the match score
+	Player1Legs int `json:"player1_legs"`
+	Player2Legs int `json:"player2_legs"`
+
+	// Who throws first in the first leg, and how it was decided
+	ThrowOffMethod   ThrowOffMethod `json:"throw_off_method,omitempty"`
+	ThrowOffWinnerID *uuid.UUID     `json:"throw_off_winner_id,omitempty"`
+
+	// Head starts for mixed-ability matches
+	Player1Handicap Handicap `json:"player1_handicap"`
+	Player2Handicap Handicap `json:"player2_handicap"`
 }
 
 // NewMatch creates a new match
@@ -39,27 +93,130 @@ func NewMatch(tournamentID uuid.UUID, round, matchNumber int) *Match {
 		Player1Score: 0,
 		Player2Score: 0,
 		Status:       MatchStatusPending,
+		BracketSide:  BracketSideWinners,
 		CreatedAt:    time.Now(),
 	}
 }
 
 // SetPlayers assigns players to the match
 func (m *Match) SetPlayers(player1ID, player2ID uuid.UUID) error {
-	if m.Status != MatchStatusPending {
+	if !m.IsWaiting() {
 		return ErrMatchAlreadyStarted
 	}
 
 	m.Player1ID = &player1ID
 	m.Player2ID = &player2ID
+	m.Status = MatchStatusReady
 	return nil
 }
 
+// AssignSlot places a player into slot 1 or 2 of a match that has not started
+// yet; the match becomes ready once both slots are filled
+func (m *Match) AssignSlot(slot int, playerID uuid.UUID) error {
+	if !m.IsWaiting() {
+		return ErrMatchAlreadyStarted
+	}
+
+	switch slot {
+	case 1:
+		m.Player1ID = &playerID
+	case 2:
+		m.Player2ID = &playerID
+	default:
+		return ErrInvalidMatchSlot
+	}
+
+	if m.Player1ID != nil && m.Player2ID != nil {
+		m.Status = MatchStatusReady
+	}
+	return nil
+}
+
+// SetGameType sets the game played in a standalone match's legs
+func (m *Match) SetGameType(gameType GameType) error {
+	if !gameType.IsValid() {
+		return ErrInvalidGameType
+	}
+	if !m.IsWaiting() {
+		return ErrMatchAlreadyStarted
+	}
+
+	m.GameType = gameType
+	return nil
+}
+
+// HasPlayers returns true if the two players are the match's participants,
+// in either order
+func (m *Match) HasPlayers(player1ID, player2ID uuid.UUID) bool {
+	if m.Player1ID == nil || m.Player2ID == nil {
+		return false
+	}
+	return (*m.Player1ID == player1ID && *m.Player2ID == player2ID) ||
+		(*m.Player1ID == player2ID && *m.Player2ID == player1ID)
+}
+
+// IsWaiting returns true if the match has not started yet
+func (m *Match) IsWaiting() bool {
+	return m.Status == MatchStatusPending || m.Status == MatchStatusReady
+}
+
+// CompleteWithBye finishes a match that has only one participant
+func (m *Match) CompleteWithBye(playerID uuid.UUID) error {
+	if !m.IsWaiting() {
+		return ErrMatchAlreadyStarted
+	}
+
+	m.WinnerID = &playerID
+	m.IsBye = true
+	m.Status = MatchStatusCompleted
+	now := time.Now()
+	m.CompletedAt = &now
+
+	return nil
+}
+
+// MarkBye flags a bracket match that will only ever receive one player
+func (m *Match) MarkBye() {
+	m.IsBye = true
+}
+
+// MarkEmpty closes a bracket match that will never receive any players
+func (m *Match) MarkEmpty() {
+	m.IsBye = true
+	m.Status = MatchStatusCompleted
+	now := time.Now()
+	m.CompletedAt = &now
+}
+
+// LinkTo sets the match (and slot) the winner of this match advances to
+func (m *Match) LinkTo(next *Match, slot int) {
+	m.NextMatchID = &next.ID
+	m.NextMatchSlot = slot
+}
+
+// LinkLoserTo sets the match (and slot) the loser of this match drops to
+func (m *Match) LinkLoserTo(next *Match, slot int) {
+	m.LoserNextMatchID = &next.ID
+	m.LoserNextMatchSlot = slot
+}
+
+// LoserID returns the participant who lost a completed match
+func (m *Match) LoserID() *uuid.UUID {
+	if m.Status != MatchStatusCompleted || m.WinnerID == nil || m.IsBye {
+		return nil
+	}
+	if m.Player1ID != nil && *m.Player1ID == *m.WinnerID {
+		return m.Player2ID
+	}
+	return m.Player1ID
+}
+
 // StartMatch begins the match
 func (m *Match) StartMatch() error {
 	if m.Player1ID == nil || m.Player2ID == nil {
 		return ErrMatchMissingPlayers
 	}
-	if m.Status != MatchStatusPending {
+	if !m.IsWaiting() {
 		return ErrMatchAlreadyStarted
 	}
 
@@ -70,15 +227,92 @@ func (m *Match) StartMatch() error {
 	return nil
 }
 
-// UpdateScore updates the match score
-func (m *Match) UpdateScore(player1Score, player2Score int) error {
+// UpdateScore updates the match score and completes the match once the score
+// decides it under the given match length. A level final score in a
+// fixed-length match is a draw.
+func (m *Match) UpdateScore(player1Score, player2Score int, length MatchLength) error {
 	if m.Status != MatchStatusInProgress {
 		return ErrMatchNotInProgress
 	}
 
+	if err := length.CheckScore(player1Score, player2Score); err != nil {
+		return err
+	}
+
 	m.Player1Score = player1Score
 	m.Player2Score = player2Score
 
+	if !length.IsComplete(player1Score, player2Score) {
+		return nil
+	}
+
+	switch {
+	case player1Score > player2Score:
+		m.WinnerID = m.Player1ID
+	case player2Score > player1Score:
+		m.WinnerID = m.Player2ID
+	}
+	m.Outcome = MatchOutcomePlayed
+	m.Status = MatchStatusCompleted
+	now := time.Now()
+	m.CompletedAt = &now
+
+	return nil
+}
+
+// UpdateSetLegs records the legs each player has won in the current set
+func (m *Match) UpdateSetLegs(player1Legs, player2Legs int) {
+	m.Player1Legs = player1Legs
+	m.Player2Legs = player2Legs
+}
+
+// RecordThrowOff records the bull-off or coin toss that decides who throws
+// first in the match's first leg
+func (m *Match) RecordThrowOff(method ThrowOffMethod, winnerID uuid.UUID) error {
+	if !method.IsValid() {
+		return ErrInvalidThrowOff
+	}
+	if m.Player1ID == nil || m.Player2ID == nil {
+		return ErrMatchMissingPlayers
+	}
+	if !m.IsWaiting() && m.Status != MatchStatusInProgress {
+		return ErrMatchNotInProgress
+	}
+	if winnerID != *m.Player1ID && winnerID != *m.Player2ID {
+		return ErrInvalidWinner
+	}
+
+	m.ThrowOffMethod = method
+	m.ThrowOffWinnerID = &winnerID
+	return nil
+}
+
+// SetHandicaps sets both players' handicaps before the first leg. Legs
+// awarded go straight onto the score: the match score, or the first set's
+// legs when playing sets (setLength is zero otherwise).
+func (m *Match) SetHandicaps(handicap1, handicap2 Handicap, length, setLength MatchLength) error {
+	if m.Player1ID == nil || m.Player2ID == nil {
+		return ErrMatchMissingPlayers
+	}
+	if !m.IsWaiting() && m.Status != MatchStatusInProgress {
+		return ErrMatchNotInProgress
+	}
+	if err := handicap1.Validate(length, setLength); err != nil {
+		return err
+	}
+	if err := handicap2.Validate(length, setLength); err != nil {
+		return err
+	}
+
+	m.Player1Handicap = handicap1
+	m.Player2Handicap = handicap2
+	m.Player1Legs = handicap1.LegsAwarded
+	m.Player2Legs = handicap2.LegsAwarded
+	m.Player1Score, m.Player2Score = 0, 0
+	if setLength.Units == 0 {
+		m.Player1Score = handicap1.LegsAwarded
+		m.Player2Score = handicap2.LegsAwarded
+	}
 	return nil
 }
 
@@ -97,6 +331,44 @@ func (m *Match) CompleteMatch(winnerID uuid.UUID) error {
 	}
 
 	m.WinnerID = &winnerID
+	m.Outcome = MatchOutcomePlayed
+	m.Status = MatchStatusCompleted
+	now := time.Now()
+	m.CompletedAt = &now
+
+	return nil
+}
+
+// Award completes a match that was not played to the end, giving it to the
+// winner. Walkovers and forfeits can be awarded before or during the match;
+// a retirement only once the match is under way, keeping the score so far.
+func (m *Match) Award(winnerID uuid.UUID, outcome MatchOutcome, reason string) error {
+	switch outcome {
+	case MatchOutcomeWalkover, MatchOutcomeForfeit:
+		if !m.IsWaiting() && m.Status != MatchStatusInProgress {
+			return ErrMatchNotInProgress
+		}
+	case MatchOutcomeRetired:
+		if m.Status != MatchStatusInProgress {
+			return ErrMatchNotInProgress
+		}
+	default:
+		return ErrInvalidMatchOutcome
+	}
+
+	if m.Player1ID == nil || m.Player2ID == nil {
+		return ErrMatchMissingPlayers
+	}
+
+	if winnerID != *m.Player1ID && winnerID != *m.Player2ID {
+		return ErrInvalidWinner
+	}
+
+	m.WinnerID = &winnerID
+	m.Outcome = outcome
+	if reason != "" {
+		m.OutcomeReason = &reason
+	}
 	m.Status = MatchStatusCompleted
 	now := time.Now()
 	m.CompletedAt = &now
@@ -104,6 +376,17 @@ func (m *Match) CompleteMatch(winnerID uuid.UUID) error {
 	return nil
 }
 
+// IsAwarded returns true if the match was completed by walkover, forfeit or
+// retirement rather than played to the end
+func (m *Match) IsAwarded() bool {
+	return m.Outcome != "" && m.Outcome != MatchOutcomePlayed
+}
+
+// IsDecided returns true if the match was completed between two players
+func (m *Match) IsDecided() bool {
+	return m.Status == MatchStatusCompleted && !m.IsBye && m.Player1ID != nil && m.Player2ID != nil
+}
+
 // GetOpponent returns the opponent of the given player
 func (m *Match) GetOpponent(playerID uuid.UUID) (*uuid.UUID, error) {
 	if m.Player1ID != nil && *m.Player1ID == playerID {
@@ -113,4 +396,9 @@ func (m *Match) GetOpponent(playerID uuid.UUID) (*uuid.UUID, error) {
 		return m.Player1ID, nil
 	}
 	return nil, ErrPlayerNotInMatch
-}
\ No newline at end of file
+}
+
+// IsValid returns true if the throw-off method is supported
+func (m ThrowOffMethod) IsValid() bool {
+	return m == ThrowOffBullOff || m == ThrowOffCoinToss
+}
@@ -0,0 +1,79 @@
+package entities
+
+// MatchFormat decides when a match is over
+type MatchFormat string
+
+const (
+	// MatchFormatBestOf ends a match as soon as one player has won a
+	// majority of the legs (or sets)
+	MatchFormatBestOf MatchFormat = "best_of"
+	// MatchFormatFixed plays every leg; a level score is a draw
+	MatchFormatFixed MatchFormat = "fixed"
+)
+
+// MatchLength is the length of a tournament's matches, counted in sets when
+// a match has more than one set and in legs otherwise. The zero value puts
+// no limit on the score, as for standalone matches.
+type MatchLength struct {
+	Format MatchFormat
+	Units  int
+
+	// Best-of only: the winning score must also be two clear of the
+	// opponent's, so play goes on past it while the score is close
+	WinByTwo bool
+}
+
+// WinningScore returns the score that wins a best-of match
+func (l MatchLength) WinningScore() int {
+	return l.Units/2 + 1
+}
+
+// CheckScore returns ErrInvalidScore if the score cannot occur in a match of
+// this length, such as 4-0 in a best of 5
+func (l MatchLength) CheckScore(score1, score2 int) error {
+	if score1 < 0 || score2 < 0 {
+		return ErrInvalidScore
+	}
+
+	switch l.Format {
+	case MatchFormatBestOf:
+		target := l.WinningScore()
+		if l.WinByTwo {
+			high, low := score1, score2
+			if low > high {
+				high, low = low, high
+			}
+			if high > target && high-low > 2 {
+				return ErrInvalidScore
+			}
+			break
+		}
+		if score1 > target || score2 > target || (score1 == target && score2 == target) {
+			return ErrInvalidScore
+		}
+	case MatchFormatFixed:
+		if score1+score2 > l.Units {
+			return ErrInvalidScore
+		}
+	}
+	return nil
+}
+
+// IsComplete returns true once the score decides the match
+func (l MatchLength) IsComplete(score1, score2 int) bool {
+	switch l.Format {
+	case MatchFormatBestOf:
+		if l.WinByTwo {
+			return (score1 >= l.WinningScore() && score1-score2 >= 2) || (score2 >= l.WinningScore() && score2-score1 >= 2)
+		}
+		return score1 == l.WinningScore() || score2 == l.WinningScore()
+	case MatchFormatFixed:
+		return score1+score2 == l.Units
+	}
+	return false
+}
+
+// IsValid returns true if the match format is supported
+func (f MatchFormat) IsValid() bool {
+	return f == MatchFormatBestOf || f == MatchFormatFixed
+}
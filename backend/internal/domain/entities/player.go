@@ -11,6 +11,7 @@ type Player struct {
 	Email     *string    `json:"email,omitempty"`
 	AvatarURL *string    `json:"avatar_url,omitempty"`
 	Nickname  *string    `json:"nickname,omitempty"`
+	Rating    *int       `json:"rating,omitempty"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
 }
@@ -46,6 +47,18 @@ func (p *Player) UpdateProfile(name string, email, nickname, avatarURL *string)
 	return nil
 }
 
+// SetRating sets the player's rating, used to seed tournaments
+func (p *Player) SetRating(rating int) error {
+	if rating < 0 {
+		return ErrInvalidRating
+	}
+
+	p.Rating = &rating
+	p.UpdatedAt = time.Now()
+
+	return nil
+}
+
 // DisplayName returns the preferred display name for the player
 func (p *Player) DisplayName() string {
 	if p.Nickname != nil && *p.Nickname != "" {
@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Elimination rounds that can be used as points schedule keys; deeper rounds
+// are named "last_16", "last_32" and so on
+const (
+	RoundWinner       = "winner"
+	RoundFinal        = "final"
+	RoundSemiFinal    = "semi_final"
+	RoundQuarterFinal = "quarter_final"
+)
+
+// PointsSchedule maps tournament results to league points. Keys are either a
+// finishing position ("1", "2", ...) or the elimination round a position
+// belongs to ("winner", "final", "semi_final", "quarter_final", "last_16",
+// ...). A position key takes precedence over its round. Round keys only apply
+// to players who reached a knockout round; round-robin and Swiss places below
+// the winner, and players out in the groups, are scored by position alone.
+type PointsSchedule map[string]int
+
+// DefaultPointsSchedule returns the schedule new leagues start with
+func DefaultPointsSchedule() PointsSchedule {
+	return PointsSchedule{
+		RoundWinner:    3,
+		RoundFinal:     2,
+		RoundSemiFinal: 1,
+	}
+}
+
+// PointsFor returns the points for a finishing position and the elimination
+// round the player reached, if any
+func (s PointsSchedule) PointsFor(position int, round string) int {
+	if points, ok := s[strconv.Itoa(position)]; ok {
+		return points
+	}
+	if round == "" {
+		return 0
+	}
+	return s[round]
+}
+
+// Validate checks that every key is a position or a known round and that no
+// result is worth negative points
+func (s PointsSchedule) Validate() error {
+	for key, points := range s {
+		if points < 0 || !isScheduleKey(key) {
+			return ErrInvalidPointsSchedule
+		}
+	}
+	return nil
+}
+
+// EliminationRound returns the round in which a bracket player finishing in
+// the given position was knocked out
+func EliminationRound(position int) string {
+	switch {
+	case position <= 1:
+		return RoundWinner
+	case position == 2:
+		return RoundFinal
+	case position <= 4:
+		return RoundSemiFinal
+	case position <= 8:
+		return RoundQuarterFinal
+	}
+
+	size := 16
+	for size < position {
+		size *= 2
+	}
+	return fmt.Sprintf("last_%d", size)
+}
+
+// isScheduleKey returns true for a finishing position or an elimination round
+func isScheduleKey(key string) bool {
+	if position, err := strconv.Atoi(key); err == nil {
+		return position >= 1
+	}
+
+	switch key {
+	case RoundWinner, RoundFinal, RoundSemiFinal, RoundQuarterFinal:
+		return true
+	}
+
+	var size int
+	if _, err := fmt.Sscanf(key, "last_%d", &size); err != nil || size < 16 || size&(size-1) != 0 {
+		return false
+	}
+	return key == fmt.Sprintf("last_%d", size)
+}
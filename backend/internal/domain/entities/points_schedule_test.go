@@ -0,0 +1,63 @@
+package entities
+
+import "testing"
+
+func TestPointsFor(t *testing.T) {
+	schedule := PointsSchedule{
+		RoundWinner:       10,
+		RoundFinal:        6,
+		RoundSemiFinal:    4,
+		RoundQuarterFinal: 2,
+		"last_16":         1,
+		"3":               5,
+	}
+
+	tests := []struct {
+		name     string
+		position int
+		round    string
+		want     int
+	}{
+		{"bracket winner", 1, RoundWinner, 10},
+		{"bracket runner-up", 2, RoundFinal, 6},
+		{"third place key beats the semi-final", 3, RoundSemiFinal, 5},
+		{"losing semi-finalist", 4, RoundSemiFinal, 4},
+		{"losing quarter-finalist", 7, RoundQuarterFinal, 2},
+		{"last 16", 12, "last_16", 1},
+		{"round-robin winner", 1, RoundWinner, 10},
+		{"round-robin third place by its position key", 3, "", 5},
+		{"round-robin fourth place reached no semi-final", 4, "", 0},
+		{"swiss sixth place reached no quarter-final", 6, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.PointsFor(tt.position, tt.round); got != tt.want {
+				t.Errorf("PointsFor(%d, %q) = %d, want %d", tt.position, tt.round, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointsForPositionWithoutSchedule(t *testing.T) {
+	league := &League{PointsForWin: 3, PointsForRunnerUp: 2, PointsForSemiFinal: 1}
+
+	tests := []struct {
+		position int
+		round    string
+		want     int
+	}{
+		{1, RoundWinner, 3},
+		{2, RoundFinal, 2},
+		{3, RoundSemiFinal, 1},
+		{4, RoundSemiFinal, 1},
+		{2, "", 0},
+		{3, "", 0},
+	}
+
+	for _, tt := range tests {
+		if got := league.PointsForPosition(tt.position, tt.round); got != tt.want {
+			t.Errorf("PointsForPosition(%d, %q) = %d, want %d", tt.position, tt.round, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScoringEventType string
+
+const (
+	ScoringEventDarts       ScoringEventType = "darts"        // darts thrown, in order
+	ScoringEventVisit       ScoringEventType = "visit"        // a visit entered as its total
+	ScoringEventCompleteLeg ScoringEventType = "complete_leg" // winner entered without darts
+	ScoringEventUndoDart    ScoringEventType = "undo_dart"    // removes the last dart
+	ScoringEventUndoVisit   ScoringEventType = "undo_visit"   // removes the last visit
+	ScoringEventEditVisit   ScoringEventType = "edit_visit"   // replaces a visit's darts or total
+)
+
+// IsCorrection returns true for events that change what was scored before
+func (t ScoringEventType) IsCorrection() bool {
+	return t == ScoringEventUndoDart || t == ScoringEventUndoVisit || t == ScoringEventEditVisit
+}
+
+// ScoringEvent is an entry in a leg's append-only scoring log. The leg's
+// throws, scores and winner are recomputed from the log, so corrections are
+// recorded as new events rather than by changing earlier ones.
+type ScoringEvent struct {
+	ID         uuid.UUID        `json:"id"`
+	GameID     uuid.UUID        `json:"game_id"`
+	Sequence   int              `json:"sequence"` // position in the leg's log, from 1
+	Type       ScoringEventType `json:"type"`
+	Darts      []Dart           `json:"darts,omitempty"`
+	Visit      *Visit           `json:"visit,omitempty"`
+	TurnNumber int              `json:"turn_number,omitempty"` // visit changed by an edit
+	WinnerID   *uuid.UUID       `json:"winner_id,omitempty"`   // winner of a completed leg
+	RecordedBy *string          `json:"recorded_by,omitempty"` // scorer who entered the event
+	Reason     *string          `json:"reason,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// NewScoringEvent creates an event for a leg's log; it is numbered when it
+// is appended
+func NewScoringEvent(gameID uuid.UUID, eventType ScoringEventType, recordedBy *string) *ScoringEvent {
+	return &ScoringEvent{
+		ID:         uuid.New(),
+		GameID:     gameID,
+		Type:       eventType,
+		RecordedBy: recordedBy,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,69 @@
+package entities
+
+import "github.com/google/uuid"
+
+// ShanghaiRounds is the number of rounds in a game of Shanghai
+const ShanghaiRounds = 7
+
+// ShanghaiRules are the rules of Shanghai, a party game. Round 1 is played
+// on the 1, round 2 on the 2 and so on; only darts in the round's number
+// score. Hitting its single, double and treble in one visit is a Shanghai,
+// which wins outright. Otherwise the higher score after the last round wins;
+// players level play on, one number higher each round and then on the bull,
+// until a round separates them.
+type ShanghaiRules struct{}
+
+// Start puts both players on no points
+func (ShanghaiRules) Start(leg *Game) {
+	leg.Player1Score = 0
+	leg.Player2Score = 0
+}
+
+// Score adds the dart's points if it hit the round's number
+func (ShanghaiRules) Score(leg *Game, match *Match, throws []*Throw, throw *Throw) error {
+	throw.Score = 0
+	if throw.Segment == ShanghaiTarget(legRound(throw.TurnNumber)) {
+		throw.Score = throw.Dart().Score()
+	}
+
+	points := leg.PlayerScore(match, throw.PlayerID) + throw.Score
+	throw.RemainingScore = points
+	leg.setPlayerScore(match, throw.PlayerID, points)
+	return nil
+}
+
+// Winner returns the thrower on a Shanghai, or the leader at the end of the
+// last round or any extra round
+func (ShanghaiRules) Winner(leg *Game, match *Match, throws []*Throw, throw *Throw) *uuid.UUID {
+	target := ShanghaiTarget(legRound(throw.TurnNumber))
+	var single, double, treble bool
+	for _, t := range turnThrows(throws, throw) {
+		if t.Segment != target {
+			continue
+		}
+		switch t.Multiplier {
+		case 1:
+			single = true
+		case 2:
+			double = true
+		case 3:
+			treble = true
+		}
+	}
+	if single && double && treble {
+		return &throw.PlayerID
+	}
+
+	if !endsRound(throw) || legRound(throw.TurnNumber) < ShanghaiRounds {
+		return nil
+	}
+	return roundLeader(leg, match)
+}
+
+// ShanghaiTarget returns the number a round of Shanghai is played on
+func ShanghaiTarget(round int) int {
+	if round > 20 {
+		return Bull
+	}
+	return round
+}
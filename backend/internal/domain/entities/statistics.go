@@ -0,0 +1,151 @@
+package entities
+
+import "github.com/google/uuid"
+
+// First9Turns is the number of opening visits the first-9 average covers
+const First9Turns = 3
+
+// LeaderboardMetric is a statistic league players can be ranked by
+type LeaderboardMetric string
+
+const (
+	LeaderboardAverage     LeaderboardMetric = "average"  // three-dart average
+	LeaderboardCheckout    LeaderboardMetric = "checkout" // checkout percentage
+	LeaderboardOneEighties LeaderboardMetric = "180s"
+	LeaderboardBestFinish  LeaderboardMetric = "best_finish"
+)
+
+// LeaderboardMetrics are the statistics league players can be ranked by
+var LeaderboardMetrics = []LeaderboardMetric{
+	LeaderboardAverage, LeaderboardCheckout, LeaderboardOneEighties, LeaderboardBestFinish,
+}
+
+// IsValid returns true if players can be ranked by the metric
+func (m LeaderboardMetric) IsValid() bool {
+	for _, metric := range LeaderboardMetrics {
+		if m == metric {
+			return true
+		}
+	}
+	return false
+}
+
+// ThrowingStats adds up a player's throwing over any number of legs. Scoring
+// and checkouts are only counted in X01 legs; darts entered as visit totals
+// count towards the averages but, with no darts recorded, not the hits.
+type ThrowingStats struct {
+	Darts       int // darts thrown in X01 legs
+	Score       int // points scored in X01 legs, busts scoring nothing
+	First9Darts int
+	First9Score int
+
+	CheckoutAttempts int // darts (or visits) thrown with a finish on
+	CheckoutHits     int
+	BestFinish       int
+	OneEighties      int
+
+	Singles int
+	Doubles int
+	Triples int
+}
+
+// AddLeg counts a player's throws in one leg, in the order they were thrown.
+// Scores are the points actually scored, so a handicap start score does not
+// change the averages.
+func (s *ThrowingStats) AddLeg(playerID uuid.UUID, throws []*Throw, gameType GameType, outRule CheckRule) {
+	var turns [][]*Throw
+	for _, throw := range throws {
+		if throw.PlayerID != playerID {
+			continue
+		}
+		if !throw.IsVisit {
+			s.addHit(throw.Dart())
+		}
+		if n := len(turns); n == 0 || turns[n-1][0].TurnNumber != throw.TurnNumber {
+			turns = append(turns, nil)
+		}
+		turns[len(turns)-1] = append(turns[len(turns)-1], throw)
+	}
+	if !gameType.IsX01() {
+		return
+	}
+
+	remaining := -1 // score before the next dart, unknown until a dart is scored
+	for i, turn := range turns {
+		darts, score, bust := 0, 0, false
+		for _, throw := range turn {
+			before := remaining
+			if !throw.IsBust {
+				before = throw.RemainingScore + throw.Score
+			}
+			if before > 0 && CanCheckout(before, throw.Darts, outRule) {
+				s.CheckoutAttempts++
+			}
+			if !throw.IsBust && throw.RemainingScore == 0 {
+				s.CheckoutHits++
+			}
+			remaining = throw.RemainingScore
+
+			darts += throw.Darts
+			score += throw.Score
+			bust = bust || throw.IsBust
+		}
+		if bust {
+			score = 0
+		}
+		if score == MaxVisit {
+			s.OneEighties++
+		}
+		if last := turn[len(turn)-1]; !bust && last.RemainingScore == 0 {
+			s.BestFinish = max(s.BestFinish, score)
+		}
+
+		s.Darts += darts
+		s.Score += score
+		if i < First9Turns {
+			s.First9Darts += darts
+			s.First9Score += score
+		}
+	}
+}
+
+// addHit counts a dart that landed in a single, double or treble
+func (s *ThrowingStats) addHit(dart Dart) {
+	switch {
+	case dart.Segment == 0:
+	case dart.IsTreble():
+		s.Triples++
+	case dart.IsDouble():
+		s.Doubles++
+	default:
+		s.Singles++
+	}
+}
+
+// Average returns the three-dart average
+func (s *ThrowingStats) Average() float64 {
+	return threeDartAverage(s.Score, s.Darts)
+}
+
+// First9Average returns the three-dart average over each leg's first nine
+// darts
+func (s *ThrowingStats) First9Average() float64 {
+	return threeDartAverage(s.First9Score, s.First9Darts)
+}
+
+// CheckoutPercentage returns the share of checkout attempts that finished a
+// leg, as a percentage
+func (s *ThrowingStats) CheckoutPercentage() float64 {
+	if s.CheckoutAttempts == 0 {
+		return 0
+	}
+	return float64(s.CheckoutHits) * 100 / float64(s.CheckoutAttempts)
+}
+
+// threeDartAverage returns the points scored per three darts
+func threeDartAverage(score, darts int) float64 {
+	if darts == 0 {
+		return 0
+	}
+	return float64(score) * DartsPerTurn / float64(darts)
+}
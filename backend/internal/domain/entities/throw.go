@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Bull is the segment number used for the outer (single) and inner (double) bull
+const Bull = 25
+
+// DartsPerTurn is the number of darts a player throws in one visit
+const DartsPerTurn = 3
+
+// Dart is where a single dart landed: the segment (0 for a miss) and its
+// multiplier (1 = single, 2 = double, 3 = treble)
+type Dart struct {
+	Segment    int `json:"segment"`
+	Multiplier int `json:"multiplier"`
+}
+
+// Validate returns ErrInvalidDart unless the dart hit a real segment of the board
+func (d Dart) Validate() error {
+	switch {
+	case d.Segment == 0:
+		if d.Multiplier < 0 || d.Multiplier > 3 {
+			return ErrInvalidDart
+		}
+	case d.Segment >= 1 && d.Segment <= 20:
+		if d.Multiplier < 1 || d.Multiplier > 3 {
+			return ErrInvalidDart
+		}
+	case d.Segment == Bull:
+		if d.Multiplier < 1 || d.Multiplier > 2 {
+			return ErrInvalidDart
+		}
+	default:
+		return ErrInvalidDart
+	}
+	return nil
+}
+
+// Score returns the points the dart scored
+func (d Dart) Score() int {
+	return d.Segment * d.Multiplier
+}
+
+// IsDouble returns true if the dart hit a double, including the inner bull
+func (d Dart) IsDouble() bool {
+	return d.Segment > 0 && d.Multiplier == 2
+}
+
+// IsTreble returns true if the dart hit a treble
+func (d Dart) IsTreble() bool {
+	return d.Segment > 0 && d.Multiplier == 3
+}
+
+// Throw is a recorded dart within a leg
+type Throw struct {
+	ID             uuid.UUID `json:"id"`
+	GameID         uuid.UUID `json:"game_id"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	TurnNumber     int       `json:"turn_number"`  // which turn in the leg
+	ThrowNumber    int       `json:"throw_number"` // 1, 2, 3 within the turn
+	Segment        int       `json:"segment"`
+	Multiplier     int       `json:"multiplier"`
+	Score          int       `json:"score"`
+	IsBust         bool      `json:"is_bust"`
+	RemainingScore int       `json:"remaining_score"` // player's score after this throw
+	CreatedAt      time.Time `json:"created_at"`
+
+	// Visit totals stand for a whole visit entered as one score
+	IsVisit bool `json:"is_visit"`
+	Darts   int  `json:"darts"` // darts the row stands for: 1, or up to 3 for a visit
+}
+
+// EndsTurn returns true if no more darts follow in the throw's visit
+func (t *Throw) EndsTurn() bool {
+	return t.IsBust || t.IsVisit || t.ThrowNumber >= DartsPerTurn
+}
+
+// Dart returns where the throw's dart landed
+func (t *Throw) Dart() Dart {
+	return Dart{Segment: t.Segment, Multiplier: t.Multiplier}
+}
+
+// NewThrow records a dart thrown by a player
+func NewThrow(gameID, playerID uuid.UUID, turnNumber, throwNumber int, dart Dart) *Throw {
+	multiplier := dart.Multiplier
+	if dart.Segment == 0 {
+		multiplier = 1 // a miss is stored as a single zero
+	}
+	return &Throw{
+		ID:          uuid.New(),
+		GameID:      gameID,
+		PlayerID:    playerID,
+		TurnNumber:  turnNumber,
+		ThrowNumber: throwNumber,
+		Segment:     dart.Segment,
+		Multiplier:  multiplier,
+		Score:       dart.Score(),
+		Darts:       1,
+		CreatedAt:   time.Now(),
+	}
+}
@@ -9,21 +9,58 @@ import (
 type TournamentType string
 type TournamentStatus string
 type GameType string
+type TableTiebreaker string
+type SeedingMethod string
 
 const (
 	TournamentTypeSingleElimination TournamentType = "single_elimination"
 	TournamentTypeDoubleElimination TournamentType = "double_elimination"
 	TournamentTypeRoundRobin        TournamentType = "round_robin"
+	TournamentTypeGroupKnockout     TournamentType = "group_knockout"
+	TournamentTypeSwiss             TournamentType = "swiss"
 
 	TournamentStatusSetup      TournamentStatus = "setup"
 	TournamentStatusInProgress TournamentStatus = "in_progress"
 	TournamentStatusCompleted  TournamentStatus = "completed"
 
-	GameType501     GameType = "501"
-	GameType301     GameType = "301"
-	GameTypeCricket GameType = "cricket"
+	GameType501              GameType = "501"
+	GameType301              GameType = "301"
+	GameTypeCricket          GameType = "cricket"
+	GameTypeCricketCutThroat GameType = "cricket_cut_throat"
+	GameTypeAroundTheClock   GameType = "around_the_clock"
+	GameTypeShanghai         GameType = "shanghai"
+	GameTypeKiller           GameType = "killer"
+	GameTypeBobs27           GameType = "bobs_27"
+
+	TiebreakerLegDifference   TableTiebreaker = "leg_difference"
+	TiebreakerLegsWon         TableTiebreaker = "legs_won"
+	TiebreakerHeadToHead      TableTiebreaker = "head_to_head"
+	TiebreakerMatchesWon      TableTiebreaker = "matches_won"
+	TiebreakerBuchholz        TableTiebreaker = "buchholz"
+	TiebreakerSonnebornBerger TableTiebreaker = "sonneborn_berger"
+
+	SeedingRegistration   SeedingMethod = "registration"
+	SeedingLeaguePosition SeedingMethod = "league_position"
+	SeedingRating         SeedingMethod = "rating"
+	SeedingManual         SeedingMethod = "manual"
+	SeedingRandom         SeedingMethod = "random"
 )
 
+// DefaultTableTiebreakers is the order used to split players level on points
+var DefaultTableTiebreakers = []TableTiebreaker{
+	TiebreakerLegDifference,
+	TiebreakerLegsWon,
+	TiebreakerHeadToHead,
+}
+
+// DefaultSwissTiebreakers is the order used in Swiss tournaments, where not
+// everyone meets and opponent strength matters
+var DefaultSwissTiebreakers = []TableTiebreaker{
+	TiebreakerBuchholz,
+	TiebreakerSonnebornBerger,
+	TiebreakerLegDifference,
+}
+
 type Tournament struct {
 	ID          uuid.UUID        `json:"id"`
 	LeagueID    uuid.UUID        `json:"league_id"`
@@ -33,11 +70,35 @@ type Tournament struct {
 	Status      TournamentStatus `json:"status"`
 
 	// Game settings
-	GameType        GameType `json:"game_type"`
-	LegsPerMatch    int      `json:"legs_per_match"`
-	SetsPerMatch    int      `json:"sets_per_match"`
-	MaxPlayers      *int     `json:"max_players,omitempty"`
-	TournamentNumber int     `json:"tournament_number"`
+	GameType         GameType `json:"game_type"`
+	LegsPerMatch     int      `json:"legs_per_match"`
+	SetsPerMatch     int      `json:"sets_per_match"`
+	MaxPlayers       *int     `json:"max_players,omitempty"`
+	TournamentNumber int      `json:"tournament_number"`
+
+	// Match length: best of LegsPerMatch legs (or SetsPerMatch sets), or a
+	// fixed number of legs that may end level. With the final-set tiebreak,
+	// the deciding set (or, without sets, the match) of a best-of match must
+	// be won by two clear legs.
+	MatchFormat      MatchFormat `json:"match_format"`
+	FinalSetTiebreak bool        `json:"final_set_tiebreak"`
+
+	// X01 rules: the score legs start on and the darts that may open and
+	// finish a leg (unused in cricket)
+	StartScore int       `json:"start_score,omitempty"`
+	InRule     CheckRule `json:"in_rule"`
+	OutRule    CheckRule `json:"out_rule"`
+
+	// Format settings
+	GrandFinalReset    bool              `json:"grand_final_reset"`
+	Tiebreakers        []TableTiebreaker `json:"tiebreakers"`
+	GroupCount         int               `json:"group_count,omitempty"`
+	QualifiersPerGroup int               `json:"qualifiers_per_group,omitempty"`
+	SwissRounds        int               `json:"swiss_rounds,omitempty"`
+
+	// Seeding, fixed when the tournament starts
+	SeedingMethod     SeedingMethod `json:"seeding_method,omitempty"`
+	SeedingRandomSeed *int64        `json:"seeding_random_seed,omitempty"`
 
 	// Financial
 	EntryFee  *float64 `json:"entry_fee,omitempty"`
@@ -56,17 +117,29 @@ func NewTournament(leagueID uuid.UUID, name string, tournamentType TournamentTyp
 		return nil, ErrInvalidTournamentName
 	}
 
+	tiebreakers := DefaultTableTiebreakers
+	if tournamentType == TournamentTypeSwiss {
+		tiebreakers = DefaultSwissTiebreakers
+	}
+
 	return &Tournament{
-		ID:               uuid.New(),
-		LeagueID:         leagueID,
-		Name:             name,
-		Type:             tournamentType,
-		Status:           TournamentStatusSetup,
-		GameType:         GameType501,
-		LegsPerMatch:     3,
-		SetsPerMatch:     1,
-		TournamentNumber: tournamentNumber,
-		CreatedAt:        time.Now(),
+		ID:                 uuid.New(),
+		LeagueID:           leagueID,
+		Name:               name,
+		Type:               tournamentType,
+		Status:             TournamentStatusSetup,
+		GameType:           GameType501,
+		LegsPerMatch:       3,
+		SetsPerMatch:       1,
+		MatchFormat:        MatchFormatBestOf,
+		StartScore:         DefaultX01Rules.StartScore,
+		InRule:             DefaultX01Rules.InRule,
+		OutRule:            DefaultX01Rules.OutRule,
+		TournamentNumber:   tournamentNumber,
+		GrandFinalReset:    true,
+		Tiebreakers:        tiebreakers,
+		QualifiersPerGroup: 2,
+		CreatedAt:          time.Now(),
 	}, nil
 }
 
@@ -96,6 +169,191 @@ func (t *Tournament) CompleteTournament() error {
 	return nil
 }
 
+// SetTiebreakers sets the order used to split players level on points in
+// round-robin tables
+func (t *Tournament) SetTiebreakers(tiebreakers []TableTiebreaker) error {
+	seen := make(map[TableTiebreaker]bool, len(tiebreakers))
+	for _, tiebreaker := range tiebreakers {
+		if !tiebreaker.IsValid() {
+			return ErrInvalidTiebreaker
+		}
+		if seen[tiebreaker] {
+			return ErrDuplicateTiebreaker
+		}
+		seen[tiebreaker] = true
+	}
+
+	t.Tiebreakers = tiebreakers
+	return nil
+}
+
+// Rename changes the tournament's name
+func (t *Tournament) Rename(name string) error {
+	if name == "" {
+		return ErrInvalidTournamentName
+	}
+
+	t.Name = name
+	return nil
+}
+
+// SetGameType sets the game played in the tournament's legs; X01 legs start
+// on the game's usual score
+func (t *Tournament) SetGameType(gameType GameType) error {
+	if !gameType.IsValid() {
+		return ErrInvalidGameType
+	}
+
+	t.GameType = gameType
+	t.StartScore = gameType.StartScore()
+	return nil
+}
+
+// SetX01Rules sets the starting score and the in- and out-rules of the
+// tournament's X01 legs
+func (t *Tournament) SetX01Rules(startScore int, inRule, outRule CheckRule) error {
+	if !t.GameType.IsX01() || startScore < 2 || !inRule.IsValid() || !outRule.IsValid() {
+		return ErrInvalidX01Rules
+	}
+
+	t.StartScore = startScore
+	t.InRule = inRule
+	t.OutRule = outRule
+	return nil
+}
+
+// GameRules returns the rules the tournament's legs are played under
+func (t *Tournament) GameRules() (GameRules, error) {
+	return NewGameRules(t.GameType, t.X01Rules())
+}
+
+// X01Rules returns the rules the tournament's X01 legs are played under
+func (t *Tournament) X01Rules() X01Rules {
+	return X01Rules{StartScore: t.StartScore, InRule: t.InRule, OutRule: t.OutRule}
+}
+
+// SetMatchFormat sets the length of the tournament's matches. Best-of
+// matches need an odd number of legs and sets so that someone always wins.
+// Fixed-length matches can end level, so they are counted in legs and only
+// played in round-robin and Swiss tournaments, which have no knockout.
+func (t *Tournament) SetMatchFormat(format MatchFormat, legs, sets int) error {
+	if !format.IsValid() || legs < 1 || sets < 1 {
+		return ErrInvalidMatchFormat
+	}
+
+	switch format {
+	case MatchFormatBestOf:
+		if legs%2 == 0 || sets%2 == 0 {
+			return ErrInvalidMatchFormat
+		}
+	case MatchFormatFixed:
+		if sets != 1 || (t.Type != TournamentTypeRoundRobin && t.Type != TournamentTypeSwiss) {
+			return ErrInvalidMatchFormat
+		}
+	}
+
+	t.MatchFormat = format
+	t.LegsPerMatch = legs
+	t.SetsPerMatch = sets
+	if format != MatchFormatBestOf {
+		t.FinalSetTiebreak = false
+	}
+	return nil
+}
+
+// SetFinalSetTiebreak sets whether the deciding set of a best-of match must
+// be won by two clear legs
+func (t *Tournament) SetFinalSetTiebreak(enabled bool) error {
+	if enabled && t.MatchFormat != MatchFormatBestOf {
+		return ErrInvalidMatchFormat
+	}
+
+	t.FinalSetTiebreak = enabled
+	return nil
+}
+
+// MatchLength returns the length of the tournament's matches; the match
+// score counts sets when a match has more than one set
+func (t *Tournament) MatchLength() MatchLength {
+	if t.SetsPerMatch > 1 {
+		return MatchLength{Format: t.MatchFormat, Units: t.SetsPerMatch}
+	}
+	return MatchLength{Format: t.MatchFormat, Units: t.LegsPerMatch, WinByTwo: t.FinalSetTiebreak}
+}
+
+// SetLength returns the length in legs of the set played at the given set
+// score, or the zero value when matches are not played in sets. With the
+// final-set tiebreak, the deciding set must be won by two clear legs.
+func (t *Tournament) SetLength(sets1, sets2 int) MatchLength {
+	if t.SetsPerMatch <= 1 {
+		return MatchLength{}
+	}
+
+	length := MatchLength{Format: MatchFormatBestOf, Units: t.LegsPerMatch}
+	deciding := t.MatchLength().WinningScore() - 1
+	length.WinByTwo = t.FinalSetTiebreak && sets1 == deciding && sets2 == deciding
+	return length
+}
+
+// SetGroupSettings sets the number of groups (0 = groups of four) and how
+// many players from each group reach the knockout stage
+func (t *Tournament) SetGroupSettings(groupCount, qualifiersPerGroup int) error {
+	if groupCount < 0 || qualifiersPerGroup < 1 {
+		return ErrInvalidGroupSettings
+	}
+
+	t.GroupCount = groupCount
+	t.QualifiersPerGroup = qualifiersPerGroup
+	return nil
+}
+
+// ResolveGroupCount fixes the number of groups for the registered field and
+// checks that every group can produce its qualifiers
+func (t *Tournament) ResolveGroupCount(players int) error {
+	groupCount := t.GroupCount
+	if groupCount == 0 {
+		groupCount = (players + 3) / 4
+	}
+
+	smallestGroup := players / groupCount
+	if groupCount < 1 || smallestGroup < 2 || smallestGroup < t.QualifiersPerGroup || groupCount*t.QualifiersPerGroup < 2 {
+		return ErrInvalidGroupSettings
+	}
+
+	t.GroupCount = groupCount
+	return nil
+}
+
+// SetSwissRounds sets the number of Swiss rounds (0 = enough rounds to
+// separate a single unbeaten player)
+func (t *Tournament) SetSwissRounds(rounds int) error {
+	if rounds < 0 {
+		return ErrInvalidSwissRounds
+	}
+
+	t.SwissRounds = rounds
+	return nil
+}
+
+// ResolveSwissRounds fixes the number of Swiss rounds for the registered
+// field. A field of n players needs ceil(log2 n) rounds; more rounds than
+// opponents would force rematches.
+func (t *Tournament) ResolveSwissRounds(players int) error {
+	rounds := t.SwissRounds
+	if rounds == 0 {
+		for size := 1; size < players; size *= 2 {
+			rounds++
+		}
+	}
+
+	if rounds < 1 || rounds > players-1 {
+		return ErrInvalidSwissRounds
+	}
+
+	t.SwissRounds = rounds
+	return nil
+}
+
 // CanAddPlayers returns true if players can still be added
 func (t *Tournament) CanAddPlayers() bool {
 	return t.Status == TournamentStatusSetup
@@ -104,4 +362,55 @@ func (t *Tournament) CanAddPlayers() bool {
 // IsInProgress returns true if tournament is currently running
 func (t *Tournament) IsInProgress() bool {
 	return t.Status == TournamentStatusInProgress
-}
\ No newline at end of file
+}
+
+// IsValid returns true if the tiebreaker is supported
+func (tb TableTiebreaker) IsValid() bool {
+	switch tb {
+	case TiebreakerLegDifference, TiebreakerLegsWon, TiebreakerHeadToHead, TiebreakerMatchesWon,
+		TiebreakerBuchholz, TiebreakerSonnebornBerger:
+		return true
+	}
+	return false
+}
+
+// IsValid returns true if the game type is supported
+func (g GameType) IsValid() bool {
+	switch g {
+	case GameType501, GameType301, GameTypeCricket, GameTypeCricketCutThroat,
+		GameTypeAroundTheClock, GameTypeShanghai, GameTypeKiller, GameTypeBobs27:
+		return true
+	}
+	return false
+}
+
+// IsX01 returns true for games played down from a starting score
+func (g GameType) IsX01() bool {
+	return g.StartScore() > 0
+}
+
+// IsCricket returns true for the cricket variants
+func (g GameType) IsCricket() bool {
+	return g == GameTypeCricket || g == GameTypeCricketCutThroat
+}
+
+// StartScore returns the score each player starts a leg on, or 0 for games
+// that are not played down from a total
+func (g GameType) StartScore() int {
+	switch g {
+	case GameType501:
+		return 501
+	case GameType301:
+		return 301
+	}
+	return 0
+}
+
+// IsValid returns true if the seeding method is supported
+func (m SeedingMethod) IsValid() bool {
+	switch m {
+	case SeedingRegistration, SeedingLeaguePosition, SeedingRating, SeedingManual, SeedingRandom:
+		return true
+	}
+	return false
+}
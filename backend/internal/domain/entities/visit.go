@@ -0,0 +1,91 @@
+package entities
+
+// MaxVisit is the highest score of a three-dart visit
+const MaxVisit = 180
+
+// Visit is a whole visit entered as its total rather than dart by dart.
+// Darts is only needed when the visit checks out with fewer than three.
+type Visit struct {
+	Total int  `json:"total"`
+	Darts int  `json:"darts,omitempty"`
+	Bust  bool `json:"bust,omitempty"`
+}
+
+// possibleVisits marks the totals that can be scored with three darts
+var possibleVisits = func() [MaxVisit + 1]bool {
+	var possible [MaxVisit + 1]bool
+	scores := []int{0}
+	for _, dart := range setupDarts() {
+		scores = append(scores, dart.Score())
+	}
+	for _, first := range scores {
+		for _, second := range scores {
+			for _, third := range scores {
+				if total := first + second + third; total <= MaxVisit {
+					possible[total] = true
+				}
+			}
+		}
+	}
+	return possible
+}()
+
+// IsPossibleVisit returns true if the total can be scored with three darts
+func IsPossibleVisit(total int) bool {
+	return total >= 0 && total <= MaxVisit && possibleVisits[total]
+}
+
+// ScoreVisit scores a visit total in an X01 leg. The visit must start a new
+// turn, and under a double- or master-in the thrower must already have
+// opened, since a total cannot show which dart opened them. A bust, or a
+// total that takes the thrower below zero or leaves 1 (unless finishing
+// straight out), leaves the score unchanged; a total that checks out must be
+// finishable with the darts used under the out-rule.
+func (g *Game) ScoreVisit(match *Match, throws []*Throw, visit Visit, rules X01Rules) (*Throw, error) {
+	if g.Status != GameStatusInProgress {
+		return nil, ErrLegNotInProgress
+	}
+	if !IsPossibleVisit(visit.Total) {
+		return nil, ErrImpossibleVisit
+	}
+
+	playerID, turn, number, err := g.NextDart(match, throws)
+	if err != nil {
+		return nil, err
+	}
+	if number != 1 {
+		return nil, ErrVisitInProgress
+	}
+
+	remaining := g.PlayerScore(match, playerID)
+	if rules.InRule != CheckRuleStraight && remaining == rules.PlayerStartScore(match, playerID) {
+		return nil, ErrVisitNeedsDarts
+	}
+	throw := NewThrow(g.ID, playerID, turn, number, Dart{})
+	throw.Score = visit.Total
+	throw.IsVisit = true
+	throw.Darts = DartsPerTurn
+	after := remaining - visit.Total
+
+	switch {
+	case visit.Bust || after < 0 || (after == 1 && rules.OutRule != CheckRuleStraight):
+		throw.IsBust = true
+		after = remaining
+	case after == 0:
+		darts := visit.Darts
+		if darts == 0 {
+			darts = DartsPerTurn
+		}
+		if !CanCheckout(remaining, darts, rules.OutRule) {
+			return nil, ErrImpossibleCheckout
+		}
+		throw.Darts = darts
+		if err := g.Complete(playerID); err != nil {
+			return nil, err
+		}
+	}
+
+	throw.RemainingScore = after
+	g.setPlayerScore(match, playerID, after)
+	return throw, nil
+}
@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestScoreVisitNeedsDartsToOpen(t *testing.T) {
+	for _, inRule := range []CheckRule{CheckRuleDouble, CheckRuleMaster} {
+		t.Run(string(inRule), func(t *testing.T) {
+			player1, player2 := uuid.New(), uuid.New()
+			match := &Match{Player1ID: &player1, Player2ID: &player2}
+			rules := X01Rules{StartScore: 301, InRule: inRule, OutRule: CheckRuleDouble}
+			leg := NewGame(uuid.New(), 1, 1, player1, rules)
+
+			if _, err := leg.ScoreVisit(match, nil, Visit{Total: 60}, rules); err != ErrVisitNeedsDarts {
+				t.Fatalf("visit before opening: got %v, want ErrVisitNeedsDarts", err)
+			}
+
+			// Open with a double, finish the visit dart by dart, then let the
+			// opponent score a visit under the same rule
+			var throws []*Throw
+			for _, dart := range []Dart{{Segment: 20, Multiplier: 2}, {Segment: 20, Multiplier: 1}, {Segment: 1, Multiplier: 1}} {
+				throw, err := leg.Throw(rules, match, throws, dart)
+				if err != nil {
+					t.Fatalf("throw %v: %v", dart, err)
+				}
+				throws = append(throws, throw)
+			}
+			if _, err := leg.ScoreVisit(match, throws, Visit{Total: 60}, rules); err != ErrVisitNeedsDarts {
+				t.Fatalf("opponent's visit before opening: got %v, want ErrVisitNeedsDarts", err)
+			}
+			throw, err := leg.Throw(rules, match, throws, Dart{Segment: 25, Multiplier: 2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			throws = append(throws, throw)
+			for i := 0; i < 2; i++ {
+				throw, err := leg.Throw(rules, match, throws, Dart{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				throws = append(throws, throw)
+			}
+
+			throw, err = leg.ScoreVisit(match, throws, Visit{Total: 100}, rules)
+			if err != nil {
+				t.Fatalf("visit after opening: %v", err)
+			}
+			if throw.RemainingScore != 301-61-100 {
+				t.Errorf("remaining %d, want %d", throw.RemainingScore, 301-61-100)
+			}
+		})
+	}
+}
+
+func TestScoreVisitStraightInNeedsNoOpening(t *testing.T) {
+	player1, player2 := uuid.New(), uuid.New()
+	match := &Match{Player1ID: &player1, Player2ID: &player2}
+	leg := NewGame(uuid.New(), 1, 1, player1, DefaultX01Rules)
+
+	throw, err := leg.ScoreVisit(match, nil, Visit{Total: 180}, DefaultX01Rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if throw.RemainingScore != 321 {
+		t.Errorf("remaining %d, want 321", throw.RemainingScore)
+	}
+}
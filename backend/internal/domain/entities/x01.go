@@ -0,0 +1,137 @@
+package entities
+
+import "github.com/google/uuid"
+
+// CheckRule is which darts may open (the in-rule) or finish (the out-rule)
+// an X01 leg
+type CheckRule string
+
+const (
+	CheckRuleStraight CheckRule = "straight" // any dart
+	CheckRuleDouble   CheckRule = "double"
+	CheckRuleMaster   CheckRule = "master" // double or treble
+)
+
+// IsValid returns true if the rule is supported
+func (r CheckRule) IsValid() bool {
+	switch r {
+	case CheckRuleStraight, CheckRuleDouble, CheckRuleMaster:
+		return true
+	}
+	return false
+}
+
+// Allows returns true if the dart may open or finish a leg under the rule
+func (r CheckRule) Allows(dart Dart) bool {
+	switch r {
+	case CheckRuleDouble:
+		return dart.IsDouble()
+	case CheckRuleMaster:
+		return dart.IsDouble() || dart.IsTreble()
+	}
+	return dart.Segment > 0
+}
+
+// X01Rules are the score an X01 leg starts on and its in- and out-rules
+type X01Rules struct {
+	StartScore int
+	InRule     CheckRule
+	OutRule    CheckRule
+
+	// Handicap start scores for the match's players; 0 starts on StartScore
+	Player1StartScore int
+	Player2StartScore int
+}
+
+// DefaultX01Rules are standard 501: straight in, double out
+var DefaultX01Rules = X01Rules{StartScore: 501, InRule: CheckRuleStraight, OutRule: CheckRuleDouble}
+
+// NextDart returns who throws the next dart of the leg and its turn and dart
+// number, given the leg's throws so far in the order they were thrown. A
+// turn ends after three darts, a bust or a visit total; the first thrower
+// opens odd turns.
+func (g *Game) NextDart(match *Match, throws []*Throw) (playerID uuid.UUID, turn, number int, err error) {
+	turn, number = 1, 1
+	if len(throws) > 0 {
+		last := throws[len(throws)-1]
+		turn, number = last.TurnNumber, last.ThrowNumber+1
+		if last.EndsTurn() {
+			turn, number = turn+1, 1
+		}
+	}
+
+	playerID = g.FirstThrowerID
+	if turn%2 == 0 {
+		opponent, err := match.GetOpponent(g.FirstThrowerID)
+		if err != nil {
+			return uuid.Nil, 0, 0, err
+		}
+		playerID = *opponent
+	}
+	return playerID, turn, number, nil
+}
+
+// WithHandicaps returns the rules with the match players' handicap start
+// scores
+func (r X01Rules) WithHandicaps(match *Match) X01Rules {
+	r.Player1StartScore = match.Player1Handicap.StartScore
+	r.Player2StartScore = match.Player2Handicap.StartScore
+	return r
+}
+
+// PlayerStartScore returns the score a match participant starts each leg on
+func (r X01Rules) PlayerStartScore(match *Match, playerID uuid.UUID) int {
+	if match.Player1ID != nil && *match.Player1ID == playerID {
+		return r.startScore(r.Player1StartScore)
+	}
+	return r.startScore(r.Player2StartScore)
+}
+
+// startScore returns a handicap start score, or the usual one without
+func (r X01Rules) startScore(handicap int) int {
+	if handicap > 0 {
+		return handicap
+	}
+	return r.StartScore
+}
+
+// Start puts both players on their starting scores
+func (r X01Rules) Start(leg *Game) {
+	leg.Player1Score = r.startScore(r.Player1StartScore)
+	leg.Player2Score = r.startScore(r.Player2StartScore)
+}
+
+// Score counts the dart down the thrower's score once they have opened with
+// a dart the in-rule allows. Going below zero, leaving 1 (unless finishing
+// straight out) or reaching zero on a dart the out-rule does not allow is a
+// bust, which ends the turn and restores the score the turn started on.
+func (r X01Rules) Score(leg *Game, match *Match, throws []*Throw, throw *Throw) error {
+	dart := throw.Dart()
+	remaining := leg.PlayerScore(match, throw.PlayerID)
+	if remaining == r.PlayerStartScore(match, throw.PlayerID) && !r.InRule.Allows(dart) {
+		throw.Score = 0 // not opened yet
+	}
+	after := remaining - throw.Score
+
+	if after < 0 || (after == 1 && r.OutRule != CheckRuleStraight) || (after == 0 && !r.OutRule.Allows(dart)) {
+		throw.IsBust = true
+		after = remaining
+		for _, earlier := range throws {
+			if earlier.TurnNumber == throw.TurnNumber {
+				after += earlier.Score
+			}
+		}
+	}
+
+	throw.RemainingScore = after
+	leg.setPlayerScore(match, throw.PlayerID, after)
+	return nil
+}
+
+// Winner returns the thrower once they check out
+func (r X01Rules) Winner(leg *Game, match *Match, throws []*Throw, throw *Throw) *uuid.UUID {
+	if throw.IsBust || throw.RemainingScore != 0 {
+		return nil
+	}
+	return &throw.PlayerID
+}
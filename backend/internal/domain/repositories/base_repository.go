@@ -26,6 +26,9 @@ type UnitOfWork interface {
 	Leagues() LeagueRepository
 	Tournaments() TournamentRepository
 	Matches() MatchRepository
+	Games() GameRepository
+	Throws() ThrowRepository
+	ScoringEvents() ScoringEventRepository
 	Standings() LeagueStandingsRepository
 	Statistics() StatisticsRepository
 
@@ -7,6 +7,9 @@ type RepositoryFactory interface {
 	NewLeagueRepository() LeagueRepository
 	NewTournamentRepository() TournamentRepository
 	NewMatchRepository() MatchRepository
+	NewGameRepository() GameRepository
+	NewThrowRepository() ThrowRepository
+	NewScoringEventRepository() ScoringEventRepository
 	NewLeagueStandingsRepository() LeagueStandingsRepository
 	NewStatisticsRepository() StatisticsRepository
 
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// GameRepository stores the legs of matches
+type GameRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, game *entities.Game) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Game, error)
+	Update(ctx context.Context, game *entities.Game) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Queries
+	GetByMatchID(ctx context.Context, matchID uuid.UUID) ([]*entities.Game, error)
+}
@@ -36,10 +36,11 @@ type LeagueStandingsRepository interface {
 
 	// Points management
 	AddPoints(ctx context.Context, leagueID, playerID uuid.UUID, points int) error
-	UpdateTournamentStats(ctx context.Context, leagueID, playerID uuid.UUID, position int) error
-	RecalculatePositions(ctx context.Context, leagueID uuid.UUID) error
+	UpdateTournamentStats(ctx context.Context, leagueID, playerID uuid.UUID, round string) error
+	RecalculatePositions(ctx context.Context, leagueID uuid.UUID, shiftPrevious bool) error
 
 	// Statistics
 	GetStandingsCount(ctx context.Context, leagueID uuid.UUID) (int64, error)
 	GetAveragePoints(ctx context.Context, leagueID uuid.UUID) (float64, error)
+	GetPlayerAverages(ctx context.Context, leagueID uuid.UUID) (map[uuid.UUID]float64, error)
 }
\ No newline at end of file
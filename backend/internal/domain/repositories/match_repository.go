@@ -29,6 +29,7 @@ type MatchRepository interface {
 	GetPlayerMatches(ctx context.Context, playerID uuid.UUID, tournamentID *uuid.UUID) ([]*entities.Match, error)
 	GetPlayerMatchesInLeague(ctx context.Context, playerID, leagueID uuid.UUID) ([]*entities.Match, error)
 	GetLiveMatchesForPlayer(ctx context.Context, playerID uuid.UUID) ([]*entities.Match, error)
+	GetHeadToHeadMatches(ctx context.Context, player1ID, player2ID uuid.UUID, leagueID *uuid.UUID) ([]*entities.Match, error)
 
 	// Bracket generation helpers
 	CreateBracketMatches(ctx context.Context, matches []*entities.Match) error
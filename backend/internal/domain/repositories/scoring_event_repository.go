@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ScoringEventRepository stores legs' append-only scoring logs; events are
+// never updated or deleted
+type ScoringEventRepository interface {
+	Create(ctx context.Context, event *entities.ScoringEvent) error
+
+	// GetByGameID returns a leg's events in sequence order
+	GetByGameID(ctx context.Context, gameID uuid.UUID) ([]*entities.ScoringEvent, error)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 	"github.com/google/uuid"
+	"darts-league-backend/internal/domain/entities"
 )
 
 // TournamentStats represents player statistics for a specific tournament
@@ -26,6 +27,10 @@ type TournamentStats struct {
 	DoublesHit            int       `json:"doubles_hit"`
 	TriplesHit            int       `json:"triples_hit"`
 	UpdatedAt             time.Time `json:"updated_at"`
+
+	OneEighties      int `json:"one_eighties"`
+	CheckoutAttempts int `json:"checkout_attempts"`
+	CheckoutHits     int `json:"checkout_hits"`
 }
 
 // LeagueStats represents aggregated player statistics across all tournaments in a league
@@ -45,6 +50,44 @@ type LeagueStats struct {
 	TournamentWins            int       `json:"tournament_wins"`
 	PodiumFinishes            int       `json:"podium_finishes"`
 	UpdatedAt                 time.Time `json:"updated_at"`
+
+	OneEighties      int `json:"one_eighties"`
+	CheckoutAttempts int `json:"checkout_attempts"`
+	CheckoutHits     int `json:"checkout_hits"`
+}
+
+// MetricValue returns the player's value for a leaderboard metric, or false
+// if they have nothing to be ranked on yet
+func (s *LeagueStats) MetricValue(metric entities.LeaderboardMetric) (float64, bool) {
+	switch metric {
+	case entities.LeaderboardAverage:
+		return s.OverallAverage, s.TotalThrows > 0
+	case entities.LeaderboardCheckout:
+		return s.OverallCheckoutPercentage, s.CheckoutAttempts > 0
+	case entities.LeaderboardOneEighties:
+		return float64(s.OneEighties), s.OneEighties > 0
+	case entities.LeaderboardBestFinish:
+		if s.BestFinish == nil {
+			return 0, false
+		}
+		return float64(*s.BestFinish), true
+	}
+	return 0, false
+}
+
+// HeadToHeadStats is a player's record in the matches they have played
+// against one opponent
+type HeadToHeadStats struct {
+	PlayerID           uuid.UUID `json:"player_id"`
+	MatchesWon         int       `json:"matches_won"`
+	LegsWon            int       `json:"legs_won"`
+	TotalThrows        int       `json:"total_throws"`
+	TotalScore         int       `json:"total_score"`
+	AverageScore       float64   `json:"average_score"`
+	First9Average      float64   `json:"first_9_average"`
+	CheckoutPercentage float64   `json:"checkout_percentage"`
+	BestFinish         *int      `json:"best_finish,omitempty"`
+	OneEighties        int       `json:"one_eighties"`
 }
 
 type StatisticsRepository interface {
@@ -63,11 +106,13 @@ type StatisticsRepository interface {
 	GetPlayerLeagueStats(ctx context.Context, playerID uuid.UUID) ([]*LeagueStats, error)
 
 	// Aggregation and calculations
+	RecalculateTournamentStats(ctx context.Context, tournamentID, playerID uuid.UUID) error
 	RecalculateLeagueStats(ctx context.Context, leagueID, playerID uuid.UUID) error
-	GetTopPerformers(ctx context.Context, leagueID uuid.UUID, metric string, limit int) ([]*LeagueStats, error)
+	GetTopPerformers(ctx context.Context, leagueID uuid.UUID, metric entities.LeaderboardMetric, limit int) ([]*LeagueStats, error)
 	GetLeagueAverages(ctx context.Context, leagueID uuid.UUID) (*LeagueStats, error)
 
 	// Comparative statistics
 	ComparePlayerStats(ctx context.Context, player1ID, player2ID, leagueID uuid.UUID) (*LeagueStats, *LeagueStats, error)
-	GetPlayerRanking(ctx context.Context, leagueID, playerID uuid.UUID, metric string) (int, error)
+	GetHeadToHeadStats(ctx context.Context, matches []*entities.Match, player1ID, player2ID uuid.UUID) (*HeadToHeadStats, *HeadToHeadStats, error)
+	GetPlayerRanking(ctx context.Context, leagueID, playerID uuid.UUID, metric entities.LeaderboardMetric) (int, error)
 }
\ No newline at end of file
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ThrowRepository stores the individual darts thrown in legs
+type ThrowRepository interface {
+	Create(ctx context.Context, throw *entities.Throw) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// DeleteByGameID removes a leg's throws before they are rebuilt from its
+	// scoring log
+	DeleteByGameID(ctx context.Context, gameID uuid.UUID) error
+
+	// GetByGameID returns a leg's throws in the order they were thrown
+	GetByGameID(ctx context.Context, gameID uuid.UUID) ([]*entities.Throw, error)
+}
@@ -7,10 +7,23 @@ import (
 	"darts-league-backend/internal/domain/entities"
 )
 
+// TournamentPlayer represents a player's registration in a tournament
+type TournamentPlayer struct {
+	TournamentID  uuid.UUID `json:"tournament_id"`
+	PlayerID      uuid.UUID `json:"player_id"`
+	Seed          *int      `json:"seed,omitempty"`
+	FinalPosition *int      `json:"final_position,omitempty"`
+	PointsEarned  int       `json:"points_earned"`
+	JoinedAt      time.Time `json:"joined_at"`
+}
+
 type TournamentRepository interface {
 	// Basic CRUD operations
 	Create(ctx context.Context, tournament *entities.Tournament) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Tournament, error)
+	// GetByIDForUpdate locks the tournament until the surrounding transaction
+	// ends, so changes that read before writing are not made twice
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*entities.Tournament, error)
 	Update(ctx context.Context, tournament *entities.Tournament) error
 	Delete(ctx context.Context, id uuid.UUID) error
 
@@ -32,7 +45,9 @@ type TournamentRepository interface {
 	RemovePlayer(ctx context.Context, tournamentID, playerID uuid.UUID) error
 	IsPlayerInTournament(ctx context.Context, tournamentID, playerID uuid.UUID) (bool, error)
 	GetTournamentPlayerCount(ctx context.Context, tournamentID uuid.UUID) (int, error)
+	GetPlayers(ctx context.Context, tournamentID uuid.UUID) ([]*TournamentPlayer, error)
 	SetPlayerPosition(ctx context.Context, tournamentID, playerID uuid.UUID, position int, points int) error
+	SetPlayerSeed(ctx context.Context, tournamentID, playerID uuid.UUID, seed int) error
 
 	// Date queries
 	GetTournamentsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entities.Tournament, error)
@@ -13,6 +13,7 @@ func ToPlayerEntity(model *Player) *entities.Player {
 		Email:     model.Email,
 		AvatarURL: model.AvatarURL,
 		Nickname:  model.Nickname,
+		Rating:    model.Rating,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 	}
@@ -26,6 +27,7 @@ func ToPlayerModel(entity *entities.Player) *Player {
 		Email:     entity.Email,
 		AvatarURL: entity.AvatarURL,
 		Nickname:  entity.Nickname,
+		Rating:    entity.Rating,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
 	}
@@ -47,6 +49,12 @@ func ToLeagueEntity(model *League) *entities.League {
 		EndDate:            model.EndDate,
 		CreatedAt:          model.CreatedAt,
 		UpdatedAt:          model.UpdatedAt,
+
+		PointsSchedule:      entities.PointsSchedule(model.PointsSchedule),
+		ParticipationPoints: model.ParticipationPoints,
+		PointsPerMatchWin:   model.PointsPerMatchWin,
+
+		StandingsTiebreakers: toStandingsTiebreakers(model.StandingsTiebreakers),
 	}
 }
 
@@ -66,90 +74,466 @@ func ToLeagueModel(entity *entities.League) *League {
 		EndDate:            entity.EndDate,
 		CreatedAt:          entity.CreatedAt,
 		UpdatedAt:          entity.UpdatedAt,
+
+		PointsSchedule:      entity.PointsSchedule,
+		ParticipationPoints: entity.ParticipationPoints,
+		PointsPerMatchWin:   entity.PointsPerMatchWin,
+
+		StandingsTiebreakers: fromStandingsTiebreakers(entity.StandingsTiebreakers),
+	}
+}
+
+// toStandingsTiebreakers converts stored standings tiebreaker names to domain values
+func toStandingsTiebreakers(names []string) []entities.StandingsTiebreaker {
+	if names == nil {
+		return entities.DefaultStandingsTiebreakers
 	}
+	tiebreakers := make([]entities.StandingsTiebreaker, len(names))
+	for i, name := range names {
+		tiebreakers[i] = entities.StandingsTiebreaker(name)
+	}
+	return tiebreakers
+}
+
+// fromStandingsTiebreakers converts domain standings tiebreakers to stored names
+func fromStandingsTiebreakers(tiebreakers []entities.StandingsTiebreaker) []string {
+	names := make([]string, len(tiebreakers))
+	for i, tiebreaker := range tiebreakers {
+		names[i] = string(tiebreaker)
+	}
+	return names
 }
 
 // ToTournamentEntity converts GORM Tournament model to domain entity
 func ToTournamentEntity(model *Tournament) *entities.Tournament {
 	return &entities.Tournament{
-		ID:               model.ID,
-		LeagueID:         model.LeagueID,
-		Name:             model.Name,
-		Description:      model.Description,
-		Type:             entities.TournamentType(model.Type),
-		Status:           entities.TournamentStatus(model.Status),
-		GameType:         entities.GameType(model.GameType),
-		LegsPerMatch:     model.LegsPerMatch,
-		SetsPerMatch:     model.SetsPerMatch,
-		MaxPlayers:       model.MaxPlayers,
-		EntryFee:         model.EntryFee,
-		PrizePool:        model.PrizePool,
-		TournamentNumber: model.TournamentNumber,
-		ScheduledDate:    model.ScheduledDate,
-		CreatedAt:        model.CreatedAt,
-		StartedAt:        model.StartedAt,
-		CompletedAt:      model.CompletedAt,
+		ID:                 model.ID,
+		LeagueID:           model.LeagueID,
+		Name:               model.Name,
+		Description:        model.Description,
+		Type:               entities.TournamentType(model.Type),
+		Status:             entities.TournamentStatus(model.Status),
+		GameType:           entities.GameType(model.GameType),
+		LegsPerMatch:       model.LegsPerMatch,
+		SetsPerMatch:       model.SetsPerMatch,
+		MatchFormat:        entities.MatchFormat(model.MatchFormat),
+		FinalSetTiebreak:   model.FinalSetTiebreak,
+		MaxPlayers:         model.MaxPlayers,
+		EntryFee:           model.EntryFee,
+		PrizePool:          model.PrizePool,
+		TournamentNumber:   model.TournamentNumber,
+		ScheduledDate:      model.ScheduledDate,
+		CreatedAt:          model.CreatedAt,
+		StartedAt:          model.StartedAt,
+		CompletedAt:        model.CompletedAt,
+		GrandFinalReset:    model.GrandFinalReset,
+		Tiebreakers:        toTiebreakers(model.Tiebreakers),
+		GroupCount:         model.GroupCount,
+		QualifiersPerGroup: model.QualifiersPerGroup,
+		SwissRounds:        model.SwissRounds,
+
+		SeedingMethod:     toSeedingMethod(model.SeedingMethod),
+		SeedingRandomSeed: model.SeedingRandomSeed,
+
+		StartScore: model.StartScore,
+		InRule:     entities.CheckRule(model.InRule),
+		OutRule:    entities.CheckRule(model.OutRule),
 	}
 }
 
 // ToTournamentModel converts domain entity to GORM Tournament model
 func ToTournamentModel(entity *entities.Tournament) *Tournament {
 	return &Tournament{
-		ID:               entity.ID,
-		LeagueID:         entity.LeagueID,
-		Name:             entity.Name,
-		Description:      entity.Description,
-		Type:             string(entity.Type),
-		Status:           string(entity.Status),
-		GameType:         string(entity.GameType),
-		LegsPerMatch:     entity.LegsPerMatch,
-		SetsPerMatch:     entity.SetsPerMatch,
-		MaxPlayers:       entity.MaxPlayers,
-		EntryFee:         entity.EntryFee,
-		PrizePool:        entity.PrizePool,
-		TournamentNumber: entity.TournamentNumber,
-		ScheduledDate:    entity.ScheduledDate,
-		CreatedAt:        entity.CreatedAt,
-		StartedAt:        entity.StartedAt,
-		CompletedAt:      entity.CompletedAt,
+		ID:                 entity.ID,
+		LeagueID:           entity.LeagueID,
+		Name:               entity.Name,
+		Description:        entity.Description,
+		Type:               string(entity.Type),
+		Status:             string(entity.Status),
+		GameType:           string(entity.GameType),
+		LegsPerMatch:       entity.LegsPerMatch,
+		SetsPerMatch:       entity.SetsPerMatch,
+		MatchFormat:        string(entity.MatchFormat),
+		FinalSetTiebreak:   entity.FinalSetTiebreak,
+		MaxPlayers:         entity.MaxPlayers,
+		EntryFee:           entity.EntryFee,
+		PrizePool:          entity.PrizePool,
+		TournamentNumber:   entity.TournamentNumber,
+		ScheduledDate:      entity.ScheduledDate,
+		CreatedAt:          entity.CreatedAt,
+		StartedAt:          entity.StartedAt,
+		CompletedAt:        entity.CompletedAt,
+		GrandFinalReset:    entity.GrandFinalReset,
+		Tiebreakers:        fromTiebreakers(entity.Tiebreakers),
+		GroupCount:         entity.GroupCount,
+		QualifiersPerGroup: entity.QualifiersPerGroup,
+		SwissRounds:        entity.SwissRounds,
+
+		SeedingMethod:     fromSeedingMethod(entity.SeedingMethod),
+		SeedingRandomSeed: entity.SeedingRandomSeed,
+
+		StartScore: entity.StartScore,
+		InRule:     string(entity.InRule),
+		OutRule:    string(entity.OutRule),
+	}
+}
+
+// toSeedingMethod converts a stored seeding method; tournaments that have
+// not started have none
+func toSeedingMethod(method *string) entities.SeedingMethod {
+	if method == nil {
+		return ""
+	}
+	return entities.SeedingMethod(*method)
+}
+
+// fromSeedingMethod converts a seeding method for storage
+func fromSeedingMethod(method entities.SeedingMethod) *string {
+	if method == "" {
+		return nil
+	}
+	name := string(method)
+	return &name
+}
+
+// toTiebreakers converts stored tiebreaker names to domain values
+func toTiebreakers(names []string) []entities.TableTiebreaker {
+	if len(names) == 0 {
+		return entities.DefaultTableTiebreakers
+	}
+	tiebreakers := make([]entities.TableTiebreaker, len(names))
+	for i, name := range names {
+		tiebreakers[i] = entities.TableTiebreaker(name)
 	}
+	return tiebreakers
+}
+
+// fromTiebreakers converts domain tiebreakers to stored names
+func fromTiebreakers(tiebreakers []entities.TableTiebreaker) []string {
+	names := make([]string, len(tiebreakers))
+	for i, tiebreaker := range tiebreakers {
+		names[i] = string(tiebreaker)
+	}
+	return names
 }
 
 // ToMatchEntity converts GORM Match model to domain entity
 func ToMatchEntity(model *Match) *entities.Match {
 	return &entities.Match{
-		ID:           model.ID,
-		TournamentID: model.TournamentID,
-		Round:        model.Round,
-		MatchNumber:  model.MatchNumber,
-		Player1ID:    model.Player1ID,
-		Player2ID:    model.Player2ID,
-		Player1Score: model.Player1Score,
-		Player2Score: model.Player2Score,
-		WinnerID:     model.WinnerID,
-		Status:       entities.MatchStatus(model.Status),
-		StartedAt:    model.StartedAt,
-		CompletedAt:  model.CompletedAt,
-		CreatedAt:    model.CreatedAt,
+		ID:                 model.ID,
+		TournamentID:       model.TournamentID,
+		Round:              model.Round,
+		MatchNumber:        model.MatchNumber,
+		Player1ID:          model.Player1ID,
+		Player2ID:          model.Player2ID,
+		Player1Score:       model.Player1Score,
+		Player2Score:       model.Player2Score,
+		WinnerID:           model.WinnerID,
+		Status:             entities.MatchStatus(model.Status),
+		IsBye:              model.IsBye,
+		BracketSide:        entities.BracketSide(model.BracketSide),
+		GroupNumber:        model.GroupNumber,
+		NextMatchID:        model.NextMatchID,
+		NextMatchSlot:      model.NextMatchSlot,
+		LoserNextMatchID:   model.LoserNextMatchID,
+		LoserNextMatchSlot: model.LoserNextMatchSlot,
+		StartedAt:          model.StartedAt,
+		CompletedAt:        model.CompletedAt,
+		CreatedAt:          model.CreatedAt,
+
+		Outcome:       toMatchOutcome(model.Outcome),
+		OutcomeReason: model.OutcomeReason,
+		GameType:      toMatchGameType(model.GameType),
+
+		Player1Legs:      model.Player1Legs,
+		Player2Legs:      model.Player2Legs,
+		ThrowOffMethod:   entities.ThrowOffMethod(model.ThrowOffMethod),
+		ThrowOffWinnerID: model.ThrowOffWinnerID,
+
+		Player1Handicap: entities.Handicap{StartScore: model.Player1HandicapStart, LegsAwarded: model.Player1HandicapLegs},
+		Player2Handicap: entities.Handicap{StartScore: model.Player2HandicapStart, LegsAwarded: model.Player2HandicapLegs},
 	}
 }
 
 // ToMatchModel converts domain entity to GORM Match model
 func ToMatchModel(entity *entities.Match) *Match {
 	return &Match{
-		ID:           entity.ID,
-		TournamentID: entity.TournamentID,
-		Round:        entity.Round,
-		MatchNumber:  entity.MatchNumber,
-		Player1ID:    entity.Player1ID,
-		Player2ID:    entity.Player2ID,
-		Player1Score: entity.Player1Score,
-		Player2Score: entity.Player2Score,
-		WinnerID:     entity.WinnerID,
-		Status:       string(entity.Status),
-		StartedAt:    entity.StartedAt,
-		CompletedAt:  entity.CompletedAt,
-		CreatedAt:    entity.CreatedAt,
+		ID:                 entity.ID,
+		TournamentID:       entity.TournamentID,
+		Round:              entity.Round,
+		MatchNumber:        entity.MatchNumber,
+		Player1ID:          entity.Player1ID,
+		Player2ID:          entity.Player2ID,
+		Player1Score:       entity.Player1Score,
+		Player2Score:       entity.Player2Score,
+		WinnerID:           entity.WinnerID,
+		Status:             string(entity.Status),
+		IsBye:              entity.IsBye,
+		BracketSide:        string(entity.BracketSide),
+		GroupNumber:        entity.GroupNumber,
+		NextMatchID:        entity.NextMatchID,
+		NextMatchSlot:      entity.NextMatchSlot,
+		LoserNextMatchID:   entity.LoserNextMatchID,
+		LoserNextMatchSlot: entity.LoserNextMatchSlot,
+		StartedAt:          entity.StartedAt,
+		CompletedAt:        entity.CompletedAt,
+		CreatedAt:          entity.CreatedAt,
+
+		Outcome:       fromMatchOutcome(entity.Outcome),
+		OutcomeReason: entity.OutcomeReason,
+		GameType:      fromMatchGameType(entity.GameType),
+
+		Player1Legs:      entity.Player1Legs,
+		Player2Legs:      entity.Player2Legs,
+		ThrowOffMethod:   string(entity.ThrowOffMethod),
+		ThrowOffWinnerID: entity.ThrowOffWinnerID,
+
+		Player1HandicapStart: entity.Player1Handicap.StartScore,
+		Player1HandicapLegs:  entity.Player1Handicap.LegsAwarded,
+		Player2HandicapStart: entity.Player2Handicap.StartScore,
+		Player2HandicapLegs:  entity.Player2Handicap.LegsAwarded,
+	}
+}
+
+// toMatchOutcome converts a stored match outcome; matches completed before
+// outcomes were recorded were played
+func toMatchOutcome(outcome *string) entities.MatchOutcome {
+	if outcome == nil {
+		return ""
+	}
+	return entities.MatchOutcome(*outcome)
+}
+
+// fromMatchOutcome converts a match outcome for storage
+func fromMatchOutcome(outcome entities.MatchOutcome) *string {
+	if outcome == "" {
+		return nil
+	}
+	name := string(outcome)
+	return &name
+}
+
+// toMatchGameType converts the stored game of a standalone match;
+// tournament matches have none
+func toMatchGameType(gameType *string) entities.GameType {
+	if gameType == nil {
+		return ""
+	}
+	return entities.GameType(*gameType)
+}
+
+// fromMatchGameType converts the game of a standalone match for storage
+func fromMatchGameType(gameType entities.GameType) *string {
+	if gameType == "" {
+		return nil
+	}
+	name := string(gameType)
+	return &name
+}
+
+// ToGameEntity converts GORM Game model to domain entity
+func ToGameEntity(model *Game) *entities.Game {
+	game := &entities.Game{
+		ID:            model.ID,
+		MatchID:       model.MatchID,
+		SetNumber:     model.SetNumber,
+		LegNumber:     model.LegNumber,
+		Player1Score:  model.Player1Score,
+		Player2Score:  model.Player2Score,
+		WinnerID:      model.WinnerID,
+		Status:        entities.GameStatus(model.Status),
+		CreatedAt:     model.CreatedAt,
+		CompletedAt:   model.CompletedAt,
+		Player1Marks:  model.Player1Marks,
+		Player2Marks:  model.Player2Marks,
+		Player1Number: model.Player1Number,
+		Player2Number: model.Player2Number,
+		Player1Killer: model.Player1Killer,
+		Player2Killer: model.Player2Killer,
+	}
+	if model.FirstThrowerID != nil {
+		game.FirstThrowerID = *model.FirstThrowerID
+	}
+	return game
+}
+
+// ToGameModel converts domain entity to GORM Game model
+func ToGameModel(entity *entities.Game) *Game {
+	firstThrowerID := entity.FirstThrowerID
+	return &Game{
+		ID:             entity.ID,
+		MatchID:        entity.MatchID,
+		SetNumber:      entity.SetNumber,
+		LegNumber:      entity.LegNumber,
+		FirstThrowerID: &firstThrowerID,
+		Player1Score:   entity.Player1Score,
+		Player2Score:   entity.Player2Score,
+		WinnerID:       entity.WinnerID,
+		Status:         string(entity.Status),
+		CreatedAt:      entity.CreatedAt,
+		CompletedAt:    entity.CompletedAt,
+		Player1Marks:   entity.Player1Marks,
+		Player2Marks:   entity.Player2Marks,
+		Player1Number:  entity.Player1Number,
+		Player2Number:  entity.Player2Number,
+		Player1Killer:  entity.Player1Killer,
+		Player2Killer:  entity.Player2Killer,
+	}
+}
+
+// ToThrowEntity converts GORM Throw model to domain entity
+func ToThrowEntity(model *Throw) *entities.Throw {
+	return &entities.Throw{
+		ID:             model.ID,
+		GameID:         model.GameID,
+		PlayerID:       model.PlayerID,
+		TurnNumber:     model.TurnNumber,
+		ThrowNumber:    model.ThrowNumber,
+		Segment:        model.Segment,
+		Multiplier:     model.Multiplier,
+		Score:          model.Score,
+		IsBust:         model.IsBust,
+		RemainingScore: model.RemainingScore,
+		CreatedAt:      model.CreatedAt,
+		IsVisit:        model.IsVisit,
+		Darts:          model.Darts,
+	}
+}
+
+// ToThrowModel converts domain entity to GORM Throw model
+func ToThrowModel(entity *entities.Throw) *Throw {
+	return &Throw{
+		ID:             entity.ID,
+		GameID:         entity.GameID,
+		PlayerID:       entity.PlayerID,
+		TurnNumber:     entity.TurnNumber,
+		ThrowNumber:    entity.ThrowNumber,
+		Segment:        entity.Segment,
+		Score:          entity.Score,
+		Multiplier:     entity.Multiplier,
+		IsBust:         entity.IsBust,
+		RemainingScore: entity.RemainingScore,
+		CreatedAt:      entity.CreatedAt,
+		IsVisit:        entity.IsVisit,
+		Darts:          entity.Darts,
+	}
+}
+
+// ToTournamentPlayerEntity converts GORM TournamentPlayer model to repository struct
+func ToTournamentPlayerEntity(model *TournamentPlayer) *repositories.TournamentPlayer {
+	return &repositories.TournamentPlayer{
+		TournamentID:  model.TournamentID,
+		PlayerID:      model.PlayerID,
+		Seed:          model.Seed,
+		FinalPosition: model.FinalPosition,
+		PointsEarned:  model.PointsEarned,
+		JoinedAt:      model.JoinedAt,
+	}
+}
+
+// ToTournamentStatsEntity converts GORM model to repository struct
+func ToTournamentStatsEntity(model *TournamentStats) *repositories.TournamentStats {
+	return &repositories.TournamentStats{
+		ID:                 model.ID,
+		TournamentID:       model.TournamentID,
+		PlayerID:           model.PlayerID,
+		FinalPosition:      model.FinalPosition,
+		MatchesPlayed:      model.MatchesPlayed,
+		MatchesWon:         model.MatchesWon,
+		LegsPlayed:         model.LegsPlayed,
+		LegsWon:            model.LegsWon,
+		TotalThrows:        model.TotalThrows,
+		TotalScore:         model.TotalScore,
+		AverageScore:       model.AverageScore,
+		BestFinish:         model.BestFinish,
+		CheckoutPercentage: model.CheckoutPercentage,
+		First9Average:      model.First9Average,
+		SinglesHit:         model.SinglesHit,
+		DoublesHit:         model.DoublesHit,
+		TriplesHit:         model.TriplesHit,
+		UpdatedAt:          model.UpdatedAt,
+
+		OneEighties:      model.OneEighties,
+		CheckoutAttempts: model.CheckoutAttempts,
+		CheckoutHits:     model.CheckoutHits,
+	}
+}
+
+// ToTournamentStatsModel converts repository struct to GORM model
+func ToTournamentStatsModel(stats *repositories.TournamentStats) *TournamentStats {
+	return &TournamentStats{
+		ID:                 stats.ID,
+		TournamentID:       stats.TournamentID,
+		PlayerID:           stats.PlayerID,
+		FinalPosition:      stats.FinalPosition,
+		MatchesPlayed:      stats.MatchesPlayed,
+		MatchesWon:         stats.MatchesWon,
+		LegsPlayed:         stats.LegsPlayed,
+		LegsWon:            stats.LegsWon,
+		TotalThrows:        stats.TotalThrows,
+		TotalScore:         stats.TotalScore,
+		AverageScore:       stats.AverageScore,
+		BestFinish:         stats.BestFinish,
+		CheckoutPercentage: stats.CheckoutPercentage,
+		First9Average:      stats.First9Average,
+		SinglesHit:         stats.SinglesHit,
+		DoublesHit:         stats.DoublesHit,
+		TriplesHit:         stats.TriplesHit,
+		UpdatedAt:          stats.UpdatedAt,
+
+		OneEighties:      stats.OneEighties,
+		CheckoutAttempts: stats.CheckoutAttempts,
+		CheckoutHits:     stats.CheckoutHits,
+	}
+}
+
+// ToLeagueStatsEntity converts GORM model to repository struct
+func ToLeagueStatsEntity(model *LeagueStats) *repositories.LeagueStats {
+	return &repositories.LeagueStats{
+		ID:                        model.ID,
+		LeagueID:                  model.LeagueID,
+		PlayerID:                  model.PlayerID,
+		TotalMatchesPlayed:        model.TotalMatchesPlayed,
+		TotalMatchesWon:           model.TotalMatchesWon,
+		TotalLegsPlayed:           model.TotalLegsPlayed,
+		TotalLegsWon:              model.TotalLegsWon,
+		TotalThrows:               model.TotalThrows,
+		TotalScore:                model.TotalScore,
+		OverallAverage:            model.OverallAverage,
+		BestFinish:                model.BestFinish,
+		OverallCheckoutPercentage: model.OverallCheckoutPercentage,
+		TournamentWins:            model.TournamentWins,
+		PodiumFinishes:            model.PodiumFinishes,
+		UpdatedAt:                 model.UpdatedAt,
+
+		OneEighties:      model.OneEighties,
+		CheckoutAttempts: model.CheckoutAttempts,
+		CheckoutHits:     model.CheckoutHits,
+	}
+}
+
+// ToLeagueStatsModel converts repository struct to GORM model
+func ToLeagueStatsModel(stats *repositories.LeagueStats) *LeagueStats {
+	return &LeagueStats{
+		ID:                        stats.ID,
+		LeagueID:                  stats.LeagueID,
+		PlayerID:                  stats.PlayerID,
+		TotalMatchesPlayed:        stats.TotalMatchesPlayed,
+		TotalMatchesWon:           stats.TotalMatchesWon,
+		TotalLegsPlayed:           stats.TotalLegsPlayed,
+		TotalLegsWon:              stats.TotalLegsWon,
+		TotalThrows:               stats.TotalThrows,
+		TotalScore:                stats.TotalScore,
+		OverallAverage:            stats.OverallAverage,
+		BestFinish:                stats.BestFinish,
+		OverallCheckoutPercentage: stats.OverallCheckoutPercentage,
+		TournamentWins:            stats.TournamentWins,
+		PodiumFinishes:            stats.PodiumFinishes,
+		UpdatedAt:                 stats.UpdatedAt,
+
+		OneEighties:      stats.OneEighties,
+		CheckoutAttempts: stats.CheckoutAttempts,
+		CheckoutHits:     stats.CheckoutHits,
 	}
 }
 
@@ -163,7 +547,11 @@ func ToLeagueStandingEntity(model *LeagueStanding) *repositories.LeagueStanding
 		playerNickname = *model.Player.Nickname
 	}
 
-	positionChange := model.CurrentPosition - model.PreviousPosition
+	// Positive when the player has moved up the table
+	positionChange := 0
+	if model.PreviousPosition > 0 && model.CurrentPosition > 0 {
+		positionChange = model.PreviousPosition - model.CurrentPosition
+	}
 
 	return &repositories.LeagueStanding{
 		ID:                model.ID,
@@ -180,4 +568,54 @@ func ToLeagueStandingEntity(model *LeagueStanding) *repositories.LeagueStanding
 		PreviousPosition:  model.PreviousPosition,
 		PositionChange:    positionChange,
 	}
-}
\ No newline at end of file
+}
+
+// ToScoringEventEntity converts GORM ScoringEvent model to domain entity
+func ToScoringEventEntity(model *ScoringEvent) *entities.ScoringEvent {
+	var darts []entities.Dart
+	for _, dart := range model.Darts {
+		darts = append(darts, entities.Dart{Segment: dart.Segment, Multiplier: dart.Multiplier})
+	}
+	var visit *entities.Visit
+	if model.Visit != nil {
+		visit = &entities.Visit{Total: model.Visit.Total, Darts: model.Visit.Darts, Bust: model.Visit.Bust}
+	}
+	return &entities.ScoringEvent{
+		ID:         model.ID,
+		GameID:     model.GameID,
+		Sequence:   model.Sequence,
+		Type:       entities.ScoringEventType(model.Type),
+		Darts:      darts,
+		Visit:      visit,
+		TurnNumber: model.TurnNumber,
+		WinnerID:   model.WinnerID,
+		RecordedBy: model.RecordedBy,
+		Reason:     model.Reason,
+		CreatedAt:  model.CreatedAt,
+	}
+}
+
+// ToScoringEventModel converts domain entity to GORM ScoringEvent model
+func ToScoringEventModel(entity *entities.ScoringEvent) *ScoringEvent {
+	var darts []DartValue
+	for _, dart := range entity.Darts {
+		darts = append(darts, DartValue{Segment: dart.Segment, Multiplier: dart.Multiplier})
+	}
+	var visit *VisitValue
+	if entity.Visit != nil {
+		visit = &VisitValue{Total: entity.Visit.Total, Darts: entity.Visit.Darts, Bust: entity.Visit.Bust}
+	}
+	return &ScoringEvent{
+		ID:         entity.ID,
+		GameID:     entity.GameID,
+		Sequence:   entity.Sequence,
+		Type:       string(entity.Type),
+		Darts:      darts,
+		Visit:      visit,
+		TurnNumber: entity.TurnNumber,
+		WinnerID:   entity.WinnerID,
+		RecordedBy: entity.RecordedBy,
+		Reason:     entity.Reason,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
@@ -28,18 +28,28 @@ func (f *repositoryFactory) NewMatchRepository() repositories.MatchRepository {
 	return NewMatchRepository(f.db)
 }
 
+func (f *repositoryFactory) NewGameRepository() repositories.GameRepository {
+	return NewGameRepository(f.db)
+}
+
+func (f *repositoryFactory) NewThrowRepository() repositories.ThrowRepository {
+	return NewThrowRepository(f.db)
+}
+
+func (f *repositoryFactory) NewScoringEventRepository() repositories.ScoringEventRepository {
+	return NewScoringEventRepository(f.db)
+}
+
 func (f *repositoryFactory) NewLeagueStandingsRepository() repositories.LeagueStandingsRepository {
 	return NewLeagueStandingsRepository(f.db)
 }
 
 func (f *repositoryFactory) NewStatisticsRepository() repositories.StatisticsRepository {
-	// TODO: Implement statistics repository when needed
-	return nil
+	return NewStatisticsRepository(f.db)
 }
 
 func (f *repositoryFactory) NewUnitOfWork() (repositories.UnitOfWork, error) {
-	// TODO: Implement unit of work when needed for complex transactions
-	return nil, nil
+	return newUnitOfWork(f.db)
 }
 
 func (f *repositoryFactory) Close() error {
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type gameRepository struct {
+	db *DB
+}
+
+func NewGameRepository(db *DB) repositories.GameRepository {
+	return &gameRepository{db: db}
+}
+
+func (r *gameRepository) Create(ctx context.Context, game *entities.Game) error {
+	model := ToGameModel(game)
+	return r.db.WithContext(ctx).Create(model).Error
+}
+
+func (r *gameRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Game, error) {
+	var model Game
+	err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrLegNotFound
+		}
+		return nil, err
+	}
+	return ToGameEntity(&model), nil
+}
+
+func (r *gameRepository) Update(ctx context.Context, game *entities.Game) error {
+	model := ToGameModel(game)
+	return r.db.WithContext(ctx).Save(model).Error
+}
+
+func (r *gameRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&Game{}, "id = ?", id).Error
+}
+
+// GetByMatchID returns a match's legs in the order they were played
+func (r *gameRepository) GetByMatchID(ctx context.Context, matchID uuid.UUID) ([]*entities.Game, error) {
+	var models []Game
+	err := r.db.WithContext(ctx).
+		Where("match_id = ?", matchID).
+		Order("set_number, leg_number").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	games := make([]*entities.Game, len(models))
+	for i, model := range models {
+		games[i] = ToGameEntity(&model)
+	}
+	return games, nil
+}
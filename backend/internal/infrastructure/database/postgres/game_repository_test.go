@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB builds statements without a database to send them to
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		DisableAutomaticPing:   true,
+	})
+	if err != nil {
+		t.Fatalf("open dry-run db: %v", err)
+	}
+	return db
+}
+
+// insertedValue returns the value an INSERT statement writes to a column, or
+// false if the column is left to its database default
+func insertedValue(stmt *gorm.Statement, column string) (interface{}, bool) {
+	sql := stmt.SQL.String()
+	start, end := strings.Index(sql, "("), strings.Index(sql, ")")
+	if start < 0 || end < start {
+		return nil, false
+	}
+	for i, name := range strings.Split(sql[start+1:end], ",") {
+		if strings.Trim(strings.TrimSpace(name), `"`) == column {
+			return stmt.Vars[i], true
+		}
+	}
+	return nil, false
+}
+
+func TestCreateCricketLegKeepsZeroScores(t *testing.T) {
+	leg := entities.NewGame(uuid.New(), 1, 1, uuid.New(), entities.CricketRules{CutThroat: true})
+	if leg.Player1Score != 0 || leg.Player2Score != 0 {
+		t.Fatalf("new cricket leg starts on %d/%d, want 0/0", leg.Player1Score, leg.Player2Score)
+	}
+
+	model := ToGameModel(leg)
+	result := dryRunDB(t).Create(model)
+	if result.Error != nil {
+		t.Fatalf("create: %v", result.Error)
+	}
+	stmt := result.Statement
+	for _, column := range []string{"player1_score", "player2_score"} {
+		value, ok := insertedValue(stmt, column)
+		if !ok {
+			t.Fatalf("%s is left to the column default: %s", column, stmt.SQL.String())
+		}
+		if value != 0 {
+			t.Errorf("%s inserted as %v, want 0", column, value)
+		}
+	}
+
+	readBack := ToGameEntity(model)
+	if readBack.Player1Score != 0 || readBack.Player2Score != 0 {
+		t.Errorf("cricket leg reads back as %d/%d, want 0/0", readBack.Player1Score, readBack.Player2Score)
+	}
+	if len(readBack.Player1Marks) == 0 || len(readBack.Player2Marks) == 0 {
+		t.Error("cricket leg reads back without mark sheets")
+	}
+}
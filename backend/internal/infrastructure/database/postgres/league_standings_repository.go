@@ -2,9 +2,13 @@ package postgres
 
 import (
 	"context"
+	"darts-league-backend/internal/domain/entities"
 	"darts-league-backend/internal/domain/repositories"
+	"sort"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type leagueStandingsRepository struct {
@@ -35,7 +39,7 @@ func (r *leagueStandingsRepository) GetLeagueStandings(ctx context.Context, leag
 	err := r.db.WithContext(ctx).
 		Preload("Player").
 		Where("league_id = ?", leagueID).
-		Order("total_points DESC").
+		Order("COALESCE(current_position, 0) = 0, current_position, total_points DESC").
 		Find(&models).Error
 	if err != nil {
 		return nil, err
@@ -48,14 +52,247 @@ func (r *leagueStandingsRepository) GetLeagueStandings(ctx context.Context, leag
 	return standings, nil
 }
 
+// AddPoints adds league points to a player's standing, creating the standing
+// if the player has none yet
+func (r *leagueStandingsRepository) AddPoints(ctx context.Context, leagueID, playerID uuid.UUID, points int) error {
+	standing := &LeagueStanding{
+		LeagueID:    leagueID,
+		PlayerID:    playerID,
+		TotalPoints: points,
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "league_id"}, {Name: "player_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"total_points": gorm.Expr("league_standings.total_points + ?", points),
+				"updated_at":   gorm.Expr("CURRENT_TIMESTAMP"),
+			}),
+		}).
+		Create(standing).Error
+}
+
+// UpdateTournamentStats counts a finished tournament in a player's standing
+// from the elimination round they reached: the winner, a losing finalist or
+// a losing semi-finalist. An empty round counts only the tournament played.
+func (r *leagueStandingsRepository) UpdateTournamentStats(ctx context.Context, leagueID, playerID uuid.UUID, round string) error {
+	won := round == entities.RoundWinner
+	final := won || round == entities.RoundFinal
+	standing := &LeagueStanding{
+		LeagueID:          leagueID,
+		PlayerID:          playerID,
+		TournamentsPlayed: 1,
+		TournamentsWon:    countIf(won),
+		FinalsReached:     countIf(final),
+		SemiFinalsReached: countIf(final || round == entities.RoundSemiFinal),
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "league_id"}, {Name: "player_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"tournaments_played":  gorm.Expr("league_standings.tournaments_played + 1"),
+				"tournaments_won":     gorm.Expr("league_standings.tournaments_won + ?", standing.TournamentsWon),
+				"finals_reached":      gorm.Expr("league_standings.finals_reached + ?", standing.FinalsReached),
+				"semi_finals_reached": gorm.Expr("league_standings.semi_finals_reached + ?", standing.SemiFinalsReached),
+				"updated_at":          gorm.Expr("CURRENT_TIMESTAMP"),
+			}),
+		}).
+		Create(standing).Error
+}
+
+// RecalculatePositions ranks the league's players by total points and splits
+// ties with the league's tiebreak chain. Players still level after every
+// tiebreaker share a position. With shiftPrevious, as after a tournament, the
+// old position moves to previous_position; otherwise the movement shown
+// since the last tournament is kept.
+func (r *leagueStandingsRepository) RecalculatePositions(ctx context.Context, leagueID uuid.UUID, shiftPrevious bool) error {
+	var league League
+	if err := r.db.WithContext(ctx).First(&league, "id = ?", leagueID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return entities.ErrLeagueNotFound
+		}
+		return err
+	}
+
+	var models []LeagueStanding
+	if err := r.db.WithContext(ctx).Where("league_id = ?", leagueID).Find(&models).Error; err != nil {
+		return err
+	}
+
+	ranking := &standingsRanking{
+		tiebreakers: toStandingsTiebreakers(league.StandingsTiebreakers),
+		headToHead:  make(map[[2]uuid.UUID]int),
+		averages:    make(map[uuid.UUID]float64),
+	}
+	if err := r.loadHeadToHead(ctx, leagueID, ranking.headToHead); err != nil {
+		return err
+	}
+	if err := r.loadLeagueAverages(ctx, leagueID, ranking.averages); err != nil {
+		return err
+	}
+
+	rows := make([]*LeagueStanding, len(models))
+	for i := range models {
+		rows[i] = &models[i]
+	}
+	positions := ranking.rank(rows)
+
+	for _, row := range rows {
+		updates := map[string]interface{}{"current_position": positions[row.ID]}
+		if shiftPrevious {
+			updates["previous_position"] = row.CurrentPosition
+		}
+		err := r.db.WithContext(ctx).
+			Model(&LeagueStanding{}).
+			Where("id = ?", row.ID).
+			Updates(updates).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadHeadToHead counts, for every pair of players, how often the first beat
+// the second in the league's tournaments. As in the statistics, matches
+// awarded by walkover, forfeit or retirement are left out.
+func (r *leagueStandingsRepository) loadHeadToHead(ctx context.Context, leagueID uuid.UUID, wins map[[2]uuid.UUID]int) error {
+	var results []struct {
+		WinnerID uuid.UUID
+		LoserID  uuid.UUID
+		Wins     int
+	}
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT m.winner_id,
+			CASE WHEN m.winner_id = m.player1_id THEN m.player2_id ELSE m.player1_id END AS loser_id,
+			COUNT(*) AS wins
+		FROM matches m
+		JOIN tournaments t ON t.id = m.tournament_id
+		WHERE t.league_id = ? AND m.status = 'completed' AND NOT m.is_bye
+			AND m.winner_id IS NOT NULL AND m.player1_id IS NOT NULL AND m.player2_id IS NOT NULL
+			AND COALESCE(m.outcome, '') IN ('', ?)
+		GROUP BY 1, 2`, leagueID, string(entities.MatchOutcomePlayed)).
+		Scan(&results).Error
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		wins[[2]uuid.UUID{result.WinnerID, result.LoserID}] = result.Wins
+	}
+	return nil
+}
+
+// GetPlayerAverages returns every player's three-dart average in the league
+func (r *leagueStandingsRepository) GetPlayerAverages(ctx context.Context, leagueID uuid.UUID) (map[uuid.UUID]float64, error) {
+	averages := make(map[uuid.UUID]float64)
+	if err := r.loadLeagueAverages(ctx, leagueID, averages); err != nil {
+		return nil, err
+	}
+	return averages, nil
+}
+
+// loadLeagueAverages reads every player's three-dart average in the league
+func (r *leagueStandingsRepository) loadLeagueAverages(ctx context.Context, leagueID uuid.UUID, averages map[uuid.UUID]float64) error {
+	var results []struct {
+		PlayerID       uuid.UUID
+		OverallAverage float64
+	}
+	err := r.db.WithContext(ctx).
+		Table("league_stats").
+		Select("player_id, overall_average").
+		Where("league_id = ?", leagueID).
+		Scan(&results).Error
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		averages[result.PlayerID] = result.OverallAverage
+	}
+	return nil
+}
+
+// standingsRanking orders league standings by points and a tiebreak chain
+type standingsRanking struct {
+	tiebreakers []entities.StandingsTiebreaker
+	headToHead  map[[2]uuid.UUID]int
+	averages    map[uuid.UUID]float64
+}
+
+// standingsKeyPoints orders the standings before any tiebreaker applies
+const standingsKeyPoints entities.StandingsTiebreaker = "total_points"
+
+// rank returns each standing's position
+func (sr *standingsRanking) rank(rows []*LeagueStanding) map[uuid.UUID]int {
+	positions := make(map[uuid.UUID]int, len(rows))
+	keys := append([]entities.StandingsTiebreaker{standingsKeyPoints}, sr.tiebreakers...)
+	sr.order(rows, keys, 1, positions)
+	return positions
+}
+
+// order sorts rows by the first key, then splits each group that is still
+// level using the remaining keys; rows level on every key share a position
+func (sr *standingsRanking) order(rows []*LeagueStanding, keys []entities.StandingsTiebreaker, first int, positions map[uuid.UUID]int) {
+	if len(keys) == 0 || len(rows) < 2 {
+		for _, row := range rows {
+			positions[row.ID] = first
+		}
+		return
+	}
+
+	values := sr.values(rows, keys[0])
+	sort.SliceStable(rows, func(i, j int) bool {
+		return values[rows[i].ID] > values[rows[j].ID]
+	})
+
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && values[rows[end].ID] == values[rows[start].ID] {
+			end++
+		}
+		sr.order(rows[start:end], keys[1:], first+start, positions)
+		start = end
+	}
+}
+
+// values returns each row's value for a sort key; higher is better
+func (sr *standingsRanking) values(rows []*LeagueStanding, key entities.StandingsTiebreaker) map[uuid.UUID]float64 {
+	values := make(map[uuid.UUID]float64, len(rows))
+	for _, row := range rows {
+		switch key {
+		case standingsKeyPoints:
+			values[row.ID] = float64(row.TotalPoints)
+		case entities.StandingsTiebreakerTournamentsWon:
+			values[row.ID] = float64(row.TournamentsWon)
+		case entities.StandingsTiebreakerFinalsReached:
+			values[row.ID] = float64(row.FinalsReached)
+		case entities.StandingsTiebreakerSemiFinals:
+			values[row.ID] = float64(row.SemiFinalsReached)
+		case entities.StandingsTiebreakerLeagueAverage:
+			values[row.ID] = sr.averages[row.PlayerID]
+		case entities.StandingsTiebreakerHeadToHead:
+			// Wins against the other tied players only
+			for _, other := range rows {
+				values[row.ID] += float64(sr.headToHead[[2]uuid.UUID{row.PlayerID, other.PlayerID}])
+			}
+		}
+	}
+	return values
+}
+
+// countIf returns 1 when the condition holds, for incrementing counters
+func countIf(condition bool) int {
+	if condition {
+		return 1
+	}
+	return 0
+}
+
 // Add stubs for other required methods
 func (r *leagueStandingsRepository) GetByLeagueAndPlayer(ctx context.Context, leagueID, playerID uuid.UUID) (*repositories.LeagueStanding, error) { return nil, nil }
 func (r *leagueStandingsRepository) Update(ctx context.Context, standing *repositories.LeagueStanding) error { return nil }
 func (r *leagueStandingsRepository) Delete(ctx context.Context, leagueID, playerID uuid.UUID) error { return nil }
 func (r *leagueStandingsRepository) GetTopPlayers(ctx context.Context, leagueID uuid.UUID, limit int) ([]*repositories.LeagueStanding, error) { return nil, nil }
 func (r *leagueStandingsRepository) GetPlayerPosition(ctx context.Context, leagueID, playerID uuid.UUID) (int, error) { return 0, nil }
-func (r *leagueStandingsRepository) AddPoints(ctx context.Context, leagueID, playerID uuid.UUID, points int) error { return nil }
-func (r *leagueStandingsRepository) UpdateTournamentStats(ctx context.Context, leagueID, playerID uuid.UUID, position int) error { return nil }
-func (r *leagueStandingsRepository) RecalculatePositions(ctx context.Context, leagueID uuid.UUID) error { return nil }
 func (r *leagueStandingsRepository) GetStandingsCount(ctx context.Context, leagueID uuid.UUID) (int64, error) { return 0, nil }
 func (r *leagueStandingsRepository) GetAveragePoints(ctx context.Context, leagueID uuid.UUID) (float64, error) { return 0, nil }
\ No newline at end of file
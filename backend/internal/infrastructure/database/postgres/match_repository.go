@@ -74,6 +74,49 @@ func (r *matchRepository) GetByPlayerID(ctx context.Context, playerID uuid.UUID,
 	return matches, nil
 }
 
+// GetHeadToHeadMatches returns the matches two players have been drawn
+// against each other in, most recent first. Given a league, only matches in
+// the league's tournaments are returned.
+func (r *matchRepository) GetHeadToHeadMatches(ctx context.Context, player1ID, player2ID uuid.UUID, leagueID *uuid.UUID) ([]*entities.Match, error) {
+	query := r.db.WithContext(ctx).
+		Where("(matches.player1_id = ? AND matches.player2_id = ?) OR (matches.player1_id = ? AND matches.player2_id = ?)",
+			player1ID, player2ID, player2ID, player1ID).
+		Where("matches.is_bye = ?", false)
+	if leagueID != nil {
+		query = query.
+			Joins("JOIN tournaments ON tournaments.id = matches.tournament_id").
+			Where("tournaments.league_id = ?", *leagueID)
+	}
+
+	var models []Match
+	err := query.
+		Order("COALESCE(matches.completed_at, matches.started_at, matches.created_at) DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*entities.Match, len(models))
+	for i, model := range models {
+		matches[i] = ToMatchEntity(&model)
+	}
+	return matches, nil
+}
+
+// CreateBracketMatches inserts a whole bracket in a single statement so that
+// matches can reference each other through next_match_id
+func (r *matchRepository) CreateBracketMatches(ctx context.Context, matches []*entities.Match) error {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	models := make([]*Match, len(matches))
+	for i, match := range matches {
+		models[i] = ToMatchModel(match)
+	}
+	return r.db.WithContext(ctx).Create(&models).Error
+}
+
 // Add stubs for other required methods
 func (r *matchRepository) GetAll(ctx context.Context, limit, offset int) ([]*entities.Match, error) { return nil, nil }
 func (r *matchRepository) GetByStatus(ctx context.Context, status entities.MatchStatus, limit, offset int) ([]*entities.Match, error) { return nil, nil }
@@ -84,7 +127,6 @@ func (r *matchRepository) GetCompletedMatches(ctx context.Context, tournamentID
 func (r *matchRepository) GetPlayerMatches(ctx context.Context, playerID uuid.UUID, tournamentID *uuid.UUID) ([]*entities.Match, error) { return nil, nil }
 func (r *matchRepository) GetPlayerMatchesInLeague(ctx context.Context, playerID, leagueID uuid.UUID) ([]*entities.Match, error) { return nil, nil }
 func (r *matchRepository) GetLiveMatchesForPlayer(ctx context.Context, playerID uuid.UUID) ([]*entities.Match, error) { return nil, nil }
-func (r *matchRepository) CreateBracketMatches(ctx context.Context, matches []*entities.Match) error { return nil }
 func (r *matchRepository) GetNextMatch(ctx context.Context, tournamentID uuid.UUID, round int) (*entities.Match, error) { return nil, nil }
 func (r *matchRepository) GetMaxRound(ctx context.Context, tournamentID uuid.UUID) (int, error) { return 0, nil }
 func (r *matchRepository) GetMatchCount(ctx context.Context) (int64, error) { return 0, nil }
@@ -14,6 +14,7 @@ type Player struct {
 	Email     *string    `gorm:"size:255;uniqueIndex"`
 	AvatarURL *string    `gorm:"size:500"`
 	Nickname  *string    `gorm:"size:50"`
+	Rating    *int
 	CreatedAt time.Time  `gorm:"autoCreateTime"`
 	UpdatedAt time.Time  `gorm:"autoUpdateTime"`
 }
@@ -37,6 +38,14 @@ type League struct {
 	EndDate            *time.Time `gorm:"type:date"`
 	CreatedAt          time.Time  `gorm:"autoCreateTime"`
 	UpdatedAt          time.Time  `gorm:"autoUpdateTime"`
+
+	// Configurable points
+	PointsSchedule      map[string]int `gorm:"type:jsonb;serializer:json"`
+	ParticipationPoints int
+	PointsPerMatchWin   int
+
+	// Standings order after league points
+	StandingsTiebreakers []string `gorm:"type:jsonb;serializer:json"`
 }
 
 func (League) TableName() string {
@@ -54,6 +63,8 @@ type Tournament struct {
 	GameType         string     `gorm:"size:50;default:'501'"`
 	LegsPerMatch     int        `gorm:"default:3"`
 	SetsPerMatch     int        `gorm:"default:1"`
+	MatchFormat      string     `gorm:"size:20;default:'best_of'"`
+	FinalSetTiebreak bool       `gorm:"default:false"`
 	MaxPlayers       *int
 	EntryFee         *float64   `gorm:"type:decimal(10,2)"`
 	PrizePool        *float64   `gorm:"type:decimal(10,2)"`
@@ -63,6 +74,22 @@ type Tournament struct {
 	StartedAt        *time.Time
 	CompletedAt      *time.Time
 
+	// Format settings
+	GrandFinalReset    bool
+	Tiebreakers        []string `gorm:"type:jsonb;serializer:json"`
+	GroupCount         int      `gorm:"default:0"`
+	QualifiersPerGroup int      `gorm:"default:2"`
+	SwissRounds        int      `gorm:"default:0"`
+
+	// Seeding
+	SeedingMethod     *string `gorm:"size:20"`
+	SeedingRandomSeed *int64
+
+	// X01 rules
+	StartScore int    `gorm:"default:501"`
+	InRule     string `gorm:"size:20;default:'straight'"`
+	OutRule    string `gorm:"size:20;default:'double'"`
+
 	// Foreign key relationship
 	League League `gorm:"foreignKey:LeagueID"`
 }
@@ -83,10 +110,38 @@ type Match struct {
 	Player2Score int        `gorm:"default:0"`
 	WinnerID     *uuid.UUID `gorm:"type:uuid;index"`
 	Status       string     `gorm:"size:50;default:'pending'"`
+	IsBye        bool       `gorm:"default:false"`
 	StartedAt    *time.Time
 	CompletedAt  *time.Time
 	CreatedAt    time.Time  `gorm:"autoCreateTime"`
 
+	// Bracket progression
+	BracketSide        string     `gorm:"size:20;default:'winners'"`
+	GroupNumber        *int
+	NextMatchID        *uuid.UUID `gorm:"type:uuid;index"`
+	NextMatchSlot      int
+	LoserNextMatchID   *uuid.UUID `gorm:"type:uuid;index"`
+	LoserNextMatchSlot int
+
+	// How a completed match was decided
+	Outcome       *string `gorm:"size:20"`
+	OutcomeReason *string `gorm:"type:text"`
+
+	// Game played in a standalone match
+	GameType *string `gorm:"size:50"`
+
+	// Legs in the current set and the throw-off for the first leg
+	Player1Legs      int        `gorm:"default:0"`
+	Player2Legs      int        `gorm:"default:0"`
+	ThrowOffMethod   string     `gorm:"size:20"`
+	ThrowOffWinnerID *uuid.UUID `gorm:"type:uuid"`
+
+	// Handicap start scores and legs awarded
+	Player1HandicapStart int `gorm:"default:0"`
+	Player1HandicapLegs  int `gorm:"default:0"`
+	Player2HandicapStart int `gorm:"default:0"`
+	Player2HandicapLegs  int `gorm:"default:0"`
+
 	// Foreign key relationships
 	Tournament Tournament `gorm:"foreignKey:TournamentID"`
 	Player1    *Player    `gorm:"foreignKey:Player1ID"`
@@ -98,6 +153,92 @@ func (Match) TableName() string {
 	return "matches"
 }
 
+// Game GORM model (a single leg of a match)
+type Game struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	MatchID        uuid.UUID  `gorm:"type:uuid;not null;index"`
+	LegNumber      int        `gorm:"not null"`
+	SetNumber      int        `gorm:"not null;default:1"`
+	FirstThrowerID *uuid.UUID `gorm:"type:uuid"`
+	Player1Score   int // set by the game's rules when the leg starts
+	Player2Score   int
+	WinnerID       *uuid.UUID `gorm:"type:uuid"`
+	Status         string     `gorm:"size:50;default:'in_progress'"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime"`
+	CompletedAt    *time.Time
+
+	// Cricket mark sheets, keyed by target
+	Player1Marks map[int]int `gorm:"type:jsonb;serializer:json"`
+	Player2Marks map[int]int `gorm:"type:jsonb;serializer:json"`
+
+	// Killer numbers and killer status
+	Player1Number int
+	Player2Number int
+	Player1Killer bool `gorm:"default:false"`
+	Player2Killer bool `gorm:"default:false"`
+
+	// Foreign key relationships
+	Match  Match   `gorm:"foreignKey:MatchID"`
+	Winner *Player `gorm:"foreignKey:WinnerID"`
+}
+
+func (Game) TableName() string {
+	return "games"
+}
+
+// Throw GORM model (a single dart)
+type Throw struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	GameID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	PlayerID       uuid.UUID `gorm:"type:uuid;not null"`
+	ThrowNumber    int       `gorm:"not null"`
+	TurnNumber     int       `gorm:"not null"`
+	Segment        int       `gorm:"not null;default:0"`
+	Score          int       `gorm:"not null"`
+	Multiplier     int       `gorm:"default:1"`
+	IsBust         bool      `gorm:"default:false"`
+	RemainingScore int
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	IsVisit        bool      `gorm:"default:false"`
+	Darts          int       `gorm:"not null;default:1"`
+}
+
+func (Throw) TableName() string {
+	return "throws"
+}
+
+// ScoringEvent GORM model
+type ScoringEvent struct {
+	ID         uuid.UUID   `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	GameID     uuid.UUID   `gorm:"type:uuid;not null;index"`
+	Sequence   int         `gorm:"not null"`
+	Type       string      `gorm:"size:20;not null"`
+	Darts      []DartValue `gorm:"type:jsonb;serializer:json"`
+	Visit      *VisitValue `gorm:"type:jsonb;serializer:json"`
+	TurnNumber int
+	WinnerID   *uuid.UUID `gorm:"type:uuid"`
+	RecordedBy *string    `gorm:"size:100"`
+	Reason     *string    `gorm:"type:text"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime"`
+}
+
+// DartValue is a dart as stored in a scoring event
+type DartValue struct {
+	Segment    int `json:"segment"`
+	Multiplier int `json:"multiplier"`
+}
+
+// VisitValue is a visit total as stored in a scoring event
+type VisitValue struct {
+	Total int  `json:"total"`
+	Darts int  `json:"darts,omitempty"`
+	Bust  bool `json:"bust,omitempty"`
+}
+
+func (ScoringEvent) TableName() string {
+	return "scoring_events"
+}
+
 // LeagueStanding GORM model
 type LeagueStanding struct {
 	ID                uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
@@ -121,6 +262,64 @@ func (LeagueStanding) TableName() string {
 	return "league_standings"
 }
 
+// TournamentStats GORM model
+type TournamentStats struct {
+	ID                 uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	TournamentID       uuid.UUID `gorm:"type:uuid;not null;index"`
+	PlayerID           uuid.UUID `gorm:"type:uuid;not null;index"`
+	FinalPosition      *int
+	MatchesPlayed      int     `gorm:"default:0"`
+	MatchesWon         int     `gorm:"default:0"`
+	LegsPlayed         int     `gorm:"default:0"`
+	LegsWon            int     `gorm:"default:0"`
+	TotalThrows        int     `gorm:"default:0"`
+	TotalScore         int     `gorm:"default:0"`
+	AverageScore       float64 `gorm:"type:decimal(5,2);default:0"`
+	BestFinish         *int
+	CheckoutPercentage float64   `gorm:"type:decimal(5,2);default:0"`
+	First9Average      float64   `gorm:"column:first_9_average;type:decimal(5,2);default:0"`
+	SinglesHit         int       `gorm:"default:0"`
+	DoublesHit         int       `gorm:"default:0"`
+	TriplesHit         int       `gorm:"default:0"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime"`
+
+	// Counts the league statistics are added up from
+	OneEighties      int `gorm:"default:0"`
+	CheckoutAttempts int `gorm:"default:0"`
+	CheckoutHits     int `gorm:"default:0"`
+}
+
+func (TournamentStats) TableName() string {
+	return "tournament_stats"
+}
+
+// LeagueStats GORM model
+type LeagueStats struct {
+	ID                        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	LeagueID                  uuid.UUID `gorm:"type:uuid;not null;index"`
+	PlayerID                  uuid.UUID `gorm:"type:uuid;not null;index"`
+	TotalMatchesPlayed        int       `gorm:"default:0"`
+	TotalMatchesWon           int       `gorm:"default:0"`
+	TotalLegsPlayed           int       `gorm:"default:0"`
+	TotalLegsWon              int       `gorm:"default:0"`
+	TotalThrows               int       `gorm:"default:0"`
+	TotalScore                int       `gorm:"default:0"`
+	OverallAverage            float64   `gorm:"type:decimal(5,2);default:0"`
+	BestFinish                *int
+	OverallCheckoutPercentage float64   `gorm:"type:decimal(5,2);default:0"`
+	TournamentWins            int       `gorm:"default:0"`
+	PodiumFinishes            int       `gorm:"default:0"`
+	UpdatedAt                 time.Time `gorm:"autoUpdateTime"`
+
+	OneEighties      int `gorm:"default:0"`
+	CheckoutAttempts int `gorm:"default:0"`
+	CheckoutHits     int `gorm:"default:0"`
+}
+
+func (LeagueStats) TableName() string {
+	return "league_stats"
+}
+
 // LeaguePlayer GORM model (junction table)
 type LeaguePlayer struct {
 	LeagueID uuid.UUID `gorm:"type:uuid;primaryKey"`
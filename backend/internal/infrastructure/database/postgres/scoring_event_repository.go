@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+type scoringEventRepository struct {
+	db *DB
+}
+
+func NewScoringEventRepository(db *DB) repositories.ScoringEventRepository {
+	return &scoringEventRepository{db: db}
+}
+
+func (r *scoringEventRepository) Create(ctx context.Context, event *entities.ScoringEvent) error {
+	model := ToScoringEventModel(event)
+	return r.db.WithContext(ctx).Create(model).Error
+}
+
+func (r *scoringEventRepository) GetByGameID(ctx context.Context, gameID uuid.UUID) ([]*entities.ScoringEvent, error) {
+	var models []ScoringEvent
+	err := r.db.WithContext(ctx).
+		Where("game_id = ?", gameID).
+		Order("sequence").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*entities.ScoringEvent, len(models))
+	for i, model := range models {
+		events[i] = ToScoringEventEntity(&model)
+	}
+	return events, nil
+}
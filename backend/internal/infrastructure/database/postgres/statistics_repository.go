@@ -0,0 +1,578 @@
+package postgres
+
+import (
+	"context"
+	"math"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type statisticsRepository struct {
+	db *DB
+}
+
+func NewStatisticsRepository(db *DB) repositories.StatisticsRepository {
+	return &statisticsRepository{db: db}
+}
+
+func (r *statisticsRepository) CreateTournamentStats(ctx context.Context, stats *repositories.TournamentStats) error {
+	model := ToTournamentStatsModel(stats)
+	return r.db.WithContext(ctx).Create(model).Error
+}
+
+func (r *statisticsRepository) GetTournamentStats(ctx context.Context, tournamentID, playerID uuid.UUID) (*repositories.TournamentStats, error) {
+	var model TournamentStats
+	err := r.db.WithContext(ctx).
+		Where("tournament_id = ? AND player_id = ?", tournamentID, playerID).
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrStatsNotFound
+		}
+		return nil, err
+	}
+	return ToTournamentStatsEntity(&model), nil
+}
+
+func (r *statisticsRepository) UpdateTournamentStats(ctx context.Context, stats *repositories.TournamentStats) error {
+	model := ToTournamentStatsModel(stats)
+	return r.db.WithContext(ctx).Save(model).Error
+}
+
+// GetAllTournamentStats returns every player's statistics in a tournament,
+// finishers first and then by three-dart average
+func (r *statisticsRepository) GetAllTournamentStats(ctx context.Context, tournamentID uuid.UUID) ([]*repositories.TournamentStats, error) {
+	var models []TournamentStats
+	err := r.db.WithContext(ctx).
+		Where("tournament_id = ?", tournamentID).
+		Order("final_position ASC NULLS LAST, average_score DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return toTournamentStatsEntities(models), nil
+}
+
+// GetPlayerTournamentStats returns a player's statistics in each tournament
+// they have played, most recently updated first
+func (r *statisticsRepository) GetPlayerTournamentStats(ctx context.Context, playerID uuid.UUID, limit, offset int) ([]*repositories.TournamentStats, error) {
+	var models []TournamentStats
+	err := r.db.WithContext(ctx).
+		Where("player_id = ?", playerID).
+		Order("updated_at DESC").
+		Limit(limit).Offset(offset).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return toTournamentStatsEntities(models), nil
+}
+
+// RecalculateTournamentStats rebuilds a player's statistics in a tournament
+// from the completed legs and throws of their matches. Matches awarded by
+// walkover, forfeit or retirement are left out.
+func (r *statisticsRepository) RecalculateTournamentStats(ctx context.Context, tournamentID, playerID uuid.UUID) error {
+	var tournamentModel Tournament
+	if err := r.db.WithContext(ctx).First(&tournamentModel, "id = ?", tournamentID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return entities.ErrTournamentNotFound
+		}
+		return err
+	}
+	tournament := ToTournamentEntity(&tournamentModel)
+
+	var matchModels []Match
+	err := r.db.WithContext(ctx).
+		Where("tournament_id = ? AND (player1_id = ? OR player2_id = ?)", tournamentID, playerID, playerID).
+		Where("is_bye = ?", false).
+		Find(&matchModels).Error
+	if err != nil {
+		return err
+	}
+
+	stats := &repositories.TournamentStats{TournamentID: tournamentID, PlayerID: playerID}
+	var matchIDs []uuid.UUID
+	for _, model := range matchModels {
+		match := ToMatchEntity(&model)
+		if match.IsAwarded() {
+			continue
+		}
+		matchIDs = append(matchIDs, match.ID)
+		if match.IsDecided() {
+			stats.MatchesPlayed++
+			if match.WinnerID != nil && *match.WinnerID == playerID {
+				stats.MatchesWon++
+			}
+		}
+	}
+
+	throwing := &entities.ThrowingStats{}
+	stats.LegsPlayed, stats.LegsWon, err = r.addThrowing(ctx, throwing, matchIDs, playerID, tournament.GameType, tournament.X01Rules().OutRule)
+	if err != nil {
+		return err
+	}
+	stats.TotalThrows = throwing.Darts
+	stats.TotalScore = throwing.Score
+	stats.AverageScore = throwing.Average()
+	stats.First9Average = throwing.First9Average()
+	stats.CheckoutPercentage = throwing.CheckoutPercentage()
+	if throwing.BestFinish > 0 {
+		stats.BestFinish = &throwing.BestFinish
+	}
+	stats.SinglesHit = throwing.Singles
+	stats.DoublesHit = throwing.Doubles
+	stats.TriplesHit = throwing.Triples
+	stats.OneEighties = throwing.OneEighties
+	stats.CheckoutAttempts = throwing.CheckoutAttempts
+	stats.CheckoutHits = throwing.CheckoutHits
+
+	var player TournamentPlayer
+	err = r.db.WithContext(ctx).
+		Where("tournament_id = ? AND player_id = ?", tournamentID, playerID).
+		Limit(1).
+		Find(&player).Error
+	if err != nil {
+		return err
+	}
+	stats.FinalPosition = player.FinalPosition
+
+	return r.saveTournamentStats(ctx, stats)
+}
+
+// addThrowing adds a player's throwing in the completed legs of the given
+// matches, all played as the given game and out-rule, to throwing, returning
+// how many of those legs they played and won
+func (r *statisticsRepository) addThrowing(ctx context.Context, throwing *entities.ThrowingStats, matchIDs []uuid.UUID, playerID uuid.UUID, gameType entities.GameType, outRule entities.CheckRule) (int, int, error) {
+	if len(matchIDs) == 0 {
+		return 0, 0, nil
+	}
+
+	var gameModels []Game
+	err := r.db.WithContext(ctx).
+		Where("match_id IN ? AND status = ?", matchIDs, string(entities.GameStatusCompleted)).
+		Order("match_id, set_number, leg_number").
+		Find(&gameModels).Error
+	if err != nil || len(gameModels) == 0 {
+		return 0, 0, err
+	}
+
+	gameIDs := make([]uuid.UUID, len(gameModels))
+	for i, model := range gameModels {
+		gameIDs[i] = model.ID
+	}
+	var throwModels []Throw
+	err = r.db.WithContext(ctx).
+		Where("game_id IN ? AND player_id = ?", gameIDs, playerID).
+		Order("game_id, turn_number, throw_number").
+		Find(&throwModels).Error
+	if err != nil {
+		return 0, 0, err
+	}
+	throws := make(map[uuid.UUID][]*entities.Throw)
+	for _, model := range throwModels {
+		throws[model.GameID] = append(throws[model.GameID], ToThrowEntity(&model))
+	}
+
+	legsWon := 0
+	for _, model := range gameModels {
+		if model.WinnerID != nil && *model.WinnerID == playerID {
+			legsWon++
+		}
+		throwing.AddLeg(playerID, throws[model.ID], gameType, outRule)
+	}
+	return len(gameModels), legsWon, nil
+}
+
+// saveTournamentStats writes a player's tournament statistics over any they
+// already have
+func (r *statisticsRepository) saveTournamentStats(ctx context.Context, stats *repositories.TournamentStats) error {
+	model := ToTournamentStatsModel(stats)
+	model.ID = uuid.New()
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "tournament_id"}, {Name: "player_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"final_position", "matches_played", "matches_won", "legs_played", "legs_won",
+				"total_throws", "total_score", "average_score", "best_finish", "checkout_percentage",
+				"first_9_average", "singles_hit", "doubles_hit", "triples_hit", "one_eighties",
+				"checkout_attempts", "checkout_hits", "updated_at",
+			}),
+		}).
+		Create(model).Error
+}
+
+// toTournamentStatsEntities converts GORM models to repository structs
+func toTournamentStatsEntities(models []TournamentStats) []*repositories.TournamentStats {
+	stats := make([]*repositories.TournamentStats, len(models))
+	for i, model := range models {
+		stats[i] = ToTournamentStatsEntity(&model)
+	}
+	return stats
+}
+
+func (r *statisticsRepository) CreateLeagueStats(ctx context.Context, stats *repositories.LeagueStats) error {
+	model := ToLeagueStatsModel(stats)
+	return r.db.WithContext(ctx).Create(model).Error
+}
+
+func (r *statisticsRepository) GetLeagueStats(ctx context.Context, leagueID, playerID uuid.UUID) (*repositories.LeagueStats, error) {
+	var model LeagueStats
+	err := r.db.WithContext(ctx).
+		Where("league_id = ? AND player_id = ?", leagueID, playerID).
+		First(&model).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrStatsNotFound
+		}
+		return nil, err
+	}
+	return ToLeagueStatsEntity(&model), nil
+}
+
+func (r *statisticsRepository) UpdateLeagueStats(ctx context.Context, stats *repositories.LeagueStats) error {
+	model := ToLeagueStatsModel(stats)
+	return r.db.WithContext(ctx).Save(model).Error
+}
+
+// GetAllLeagueStats returns every player's statistics in a league, best
+// three-dart average first
+func (r *statisticsRepository) GetAllLeagueStats(ctx context.Context, leagueID uuid.UUID) ([]*repositories.LeagueStats, error) {
+	var models []LeagueStats
+	err := r.db.WithContext(ctx).
+		Where("league_id = ?", leagueID).
+		Order("overall_average DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return toLeagueStatsEntities(models), nil
+}
+
+// GetPlayerLeagueStats returns a player's statistics in each league they
+// have played in
+func (r *statisticsRepository) GetPlayerLeagueStats(ctx context.Context, playerID uuid.UUID) ([]*repositories.LeagueStats, error) {
+	var models []LeagueStats
+	err := r.db.WithContext(ctx).
+		Where("player_id = ?", playerID).
+		Order("updated_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return toLeagueStatsEntities(models), nil
+}
+
+// RecalculateLeagueStats adds up a player's statistics from every tournament
+// of the league: counts are summed, the average and checkout percentage are
+// worked out again from the summed darts, points and checkouts, and first
+// and top-three finishes count as tournament wins and podiums
+func (r *statisticsRepository) RecalculateLeagueStats(ctx context.Context, leagueID, playerID uuid.UUID) error {
+	var models []TournamentStats
+	err := r.db.WithContext(ctx).
+		Joins("JOIN tournaments ON tournaments.id = tournament_stats.tournament_id").
+		Where("tournaments.league_id = ? AND tournament_stats.player_id = ?", leagueID, playerID).
+		Find(&models).Error
+	if err != nil {
+		return err
+	}
+
+	stats := &repositories.LeagueStats{LeagueID: leagueID, PlayerID: playerID}
+	throwing := entities.ThrowingStats{}
+	for _, model := range models {
+		stats.TotalMatchesPlayed += model.MatchesPlayed
+		stats.TotalMatchesWon += model.MatchesWon
+		stats.TotalLegsPlayed += model.LegsPlayed
+		stats.TotalLegsWon += model.LegsWon
+
+		throwing.Darts += model.TotalThrows
+		throwing.Score += model.TotalScore
+		throwing.CheckoutAttempts += model.CheckoutAttempts
+		throwing.CheckoutHits += model.CheckoutHits
+		throwing.OneEighties += model.OneEighties
+		if model.BestFinish != nil {
+			throwing.BestFinish = max(throwing.BestFinish, *model.BestFinish)
+		}
+
+		if model.FinalPosition != nil && *model.FinalPosition == 1 {
+			stats.TournamentWins++
+		}
+		if model.FinalPosition != nil && *model.FinalPosition <= 3 {
+			stats.PodiumFinishes++
+		}
+	}
+
+	stats.TotalThrows = throwing.Darts
+	stats.TotalScore = throwing.Score
+	stats.OverallAverage = throwing.Average()
+	stats.OverallCheckoutPercentage = throwing.CheckoutPercentage()
+	stats.CheckoutAttempts = throwing.CheckoutAttempts
+	stats.CheckoutHits = throwing.CheckoutHits
+	stats.OneEighties = throwing.OneEighties
+	if throwing.BestFinish > 0 {
+		stats.BestFinish = &throwing.BestFinish
+	}
+
+	model := ToLeagueStatsModel(stats)
+	model.ID = uuid.New()
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "league_id"}, {Name: "player_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"total_matches_played", "total_matches_won", "total_legs_played", "total_legs_won",
+				"total_throws", "total_score", "overall_average", "best_finish", "overall_checkout_percentage",
+				"one_eighties", "checkout_attempts", "checkout_hits", "tournament_wins", "podium_finishes",
+				"updated_at",
+			}),
+		}).
+		Create(model).Error
+}
+
+// leaderboardColumns are the league_stats columns players are ranked on for
+// each metric, with the rows that have something to rank
+var leaderboardColumns = map[entities.LeaderboardMetric]struct{ column, ranked string }{
+	entities.LeaderboardAverage:     {"overall_average", "total_throws > 0"},
+	entities.LeaderboardCheckout:    {"overall_checkout_percentage", "checkout_attempts > 0"},
+	entities.LeaderboardOneEighties: {"one_eighties", "one_eighties > 0"},
+	entities.LeaderboardBestFinish:  {"best_finish", "best_finish IS NOT NULL"},
+}
+
+// GetTopPerformers returns the league's best players by a metric, best first
+func (r *statisticsRepository) GetTopPerformers(ctx context.Context, leagueID uuid.UUID, metric entities.LeaderboardMetric, limit int) ([]*repositories.LeagueStats, error) {
+	leaderboard, ok := leaderboardColumns[metric]
+	if !ok {
+		return nil, entities.ErrInvalidMetric
+	}
+
+	var models []LeagueStats
+	err := r.db.WithContext(ctx).
+		Where("league_id = ?", leagueID).
+		Where(leaderboard.ranked).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: leaderboard.column}, Desc: true}).
+		Order("total_throws DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return toLeagueStatsEntities(models), nil
+}
+
+// GetLeagueAverages returns the league's average player: each statistic is
+// the mean over the league's players with darts recorded, rounded for counts
+func (r *statisticsRepository) GetLeagueAverages(ctx context.Context, leagueID uuid.UUID) (*repositories.LeagueStats, error) {
+	var means struct {
+		Players                   int64
+		TotalMatchesPlayed        float64
+		TotalMatchesWon           float64
+		TotalLegsPlayed           float64
+		TotalLegsWon              float64
+		TotalThrows               float64
+		TotalScore                float64
+		OverallAverage            float64
+		BestFinish                *float64
+		OverallCheckoutPercentage float64
+		OneEighties               float64
+		CheckoutAttempts          float64
+		CheckoutHits              float64
+		TournamentWins            float64
+		PodiumFinishes            float64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&LeagueStats{}).
+		Select(`COUNT(*) AS players,
+			COALESCE(AVG(total_matches_played), 0) AS total_matches_played,
+			COALESCE(AVG(total_matches_won), 0) AS total_matches_won,
+			COALESCE(AVG(total_legs_played), 0) AS total_legs_played,
+			COALESCE(AVG(total_legs_won), 0) AS total_legs_won,
+			COALESCE(AVG(total_throws), 0) AS total_throws,
+			COALESCE(AVG(total_score), 0) AS total_score,
+			COALESCE(AVG(overall_average), 0) AS overall_average,
+			AVG(best_finish) AS best_finish,
+			COALESCE(AVG(overall_checkout_percentage) FILTER (WHERE checkout_attempts > 0), 0) AS overall_checkout_percentage,
+			COALESCE(AVG(one_eighties), 0) AS one_eighties,
+			COALESCE(AVG(checkout_attempts), 0) AS checkout_attempts,
+			COALESCE(AVG(checkout_hits), 0) AS checkout_hits,
+			COALESCE(AVG(tournament_wins), 0) AS tournament_wins,
+			COALESCE(AVG(podium_finishes), 0) AS podium_finishes`).
+		Where("league_id = ? AND total_throws > 0", leagueID).
+		Scan(&means).Error
+	if err != nil {
+		return nil, err
+	}
+	if means.Players == 0 {
+		return nil, entities.ErrStatsNotFound
+	}
+
+	averages := &repositories.LeagueStats{
+		LeagueID:                  leagueID,
+		TotalMatchesPlayed:        int(math.Round(means.TotalMatchesPlayed)),
+		TotalMatchesWon:           int(math.Round(means.TotalMatchesWon)),
+		TotalLegsPlayed:           int(math.Round(means.TotalLegsPlayed)),
+		TotalLegsWon:              int(math.Round(means.TotalLegsWon)),
+		TotalThrows:               int(math.Round(means.TotalThrows)),
+		TotalScore:                int(math.Round(means.TotalScore)),
+		OverallAverage:            means.OverallAverage,
+		OverallCheckoutPercentage: means.OverallCheckoutPercentage,
+		OneEighties:               int(math.Round(means.OneEighties)),
+		CheckoutAttempts:          int(math.Round(means.CheckoutAttempts)),
+		CheckoutHits:              int(math.Round(means.CheckoutHits)),
+		TournamentWins:            int(math.Round(means.TournamentWins)),
+		PodiumFinishes:            int(math.Round(means.PodiumFinishes)),
+	}
+	if means.BestFinish != nil {
+		bestFinish := int(math.Round(*means.BestFinish))
+		averages.BestFinish = &bestFinish
+	}
+	return averages, nil
+}
+
+// ComparePlayerStats returns two players' statistics in a league. A player
+// with no statistics in the league yet is returned as nil.
+func (r *statisticsRepository) ComparePlayerStats(ctx context.Context, player1ID, player2ID, leagueID uuid.UUID) (*repositories.LeagueStats, *repositories.LeagueStats, error) {
+	stats1, err := r.GetLeagueStats(ctx, leagueID, player1ID)
+	if err != nil && err != entities.ErrStatsNotFound {
+		return nil, nil, err
+	}
+	stats2, err := r.GetLeagueStats(ctx, leagueID, player2ID)
+	if err != nil && err != entities.ErrStatsNotFound {
+		return nil, nil, err
+	}
+	return stats1, stats2, nil
+}
+
+// GetHeadToHeadStats adds up both players' records in the given matches
+// between them. As in the tournament statistics, matches awarded by walkover,
+// forfeit or retirement are left out and only completed legs count. Legs are
+// scored under their tournament's rules, or for standalone matches under the
+// match's own game, 501 by default, with the default X01 rules.
+func (r *statisticsRepository) GetHeadToHeadStats(ctx context.Context, matches []*entities.Match, player1ID, player2ID uuid.UUID) (*repositories.HeadToHeadStats, *repositories.HeadToHeadStats, error) {
+	stats1 := &repositories.HeadToHeadStats{PlayerID: player1ID}
+	stats2 := &repositories.HeadToHeadStats{PlayerID: player2ID}
+
+	matchIDs := make(map[uuid.UUID][]uuid.UUID) // by tournament, whose rules the legs were played under
+	standalone := make(map[entities.GameType][]uuid.UUID)
+	var tournamentIDs []uuid.UUID
+	var gameTypes []entities.GameType
+	for _, match := range matches {
+		if match.IsAwarded() {
+			continue
+		}
+		if match.TournamentID == uuid.Nil {
+			gameType := match.GameType
+			if gameType == "" {
+				gameType = entities.GameType501
+			}
+			if _, ok := standalone[gameType]; !ok {
+				gameTypes = append(gameTypes, gameType)
+			}
+			standalone[gameType] = append(standalone[gameType], match.ID)
+		} else {
+			if _, ok := matchIDs[match.TournamentID]; !ok {
+				tournamentIDs = append(tournamentIDs, match.TournamentID)
+			}
+			matchIDs[match.TournamentID] = append(matchIDs[match.TournamentID], match.ID)
+		}
+		if match.IsDecided() && match.WinnerID != nil {
+			switch *match.WinnerID {
+			case player1ID:
+				stats1.MatchesWon++
+			case player2ID:
+				stats2.MatchesWon++
+			}
+		}
+	}
+
+	var tournamentModels []Tournament
+	if len(tournamentIDs) > 0 {
+		if err := r.db.WithContext(ctx).Where("id IN ?", tournamentIDs).Find(&tournamentModels).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+
+	throwing1, throwing2 := &entities.ThrowingStats{}, &entities.ThrowingStats{}
+	addLegs := func(matchIDs []uuid.UUID, gameType entities.GameType, outRule entities.CheckRule) error {
+		_, legsWon, err := r.addThrowing(ctx, throwing1, matchIDs, player1ID, gameType, outRule)
+		if err != nil {
+			return err
+		}
+		stats1.LegsWon += legsWon
+		_, legsWon, err = r.addThrowing(ctx, throwing2, matchIDs, player2ID, gameType, outRule)
+		if err != nil {
+			return err
+		}
+		stats2.LegsWon += legsWon
+		return nil
+	}
+	for _, model := range tournamentModels {
+		tournament := ToTournamentEntity(&model)
+		if err := addLegs(matchIDs[tournament.ID], tournament.GameType, tournament.X01Rules().OutRule); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, gameType := range gameTypes {
+		if err := addLegs(standalone[gameType], gameType, entities.DefaultX01Rules.OutRule); err != nil {
+			return nil, nil, err
+		}
+	}
+	setHeadToHeadThrowing(stats1, throwing1)
+	setHeadToHeadThrowing(stats2, throwing2)
+	return stats1, stats2, nil
+}
+
+// setHeadToHeadThrowing copies a player's throwing into their head-to-head
+// record
+func setHeadToHeadThrowing(stats *repositories.HeadToHeadStats, throwing *entities.ThrowingStats) {
+	stats.TotalThrows = throwing.Darts
+	stats.TotalScore = throwing.Score
+	stats.AverageScore = throwing.Average()
+	stats.First9Average = throwing.First9Average()
+	stats.CheckoutPercentage = throwing.CheckoutPercentage()
+	if throwing.BestFinish > 0 {
+		stats.BestFinish = &throwing.BestFinish
+	}
+	stats.OneEighties = throwing.OneEighties
+}
+
+// GetPlayerRanking returns a player's position in the league by a metric:
+// one more than the number of players ahead of them
+func (r *statisticsRepository) GetPlayerRanking(ctx context.Context, leagueID, playerID uuid.UUID, metric entities.LeaderboardMetric) (int, error) {
+	leaderboard, ok := leaderboardColumns[metric]
+	if !ok {
+		return 0, entities.ErrInvalidMetric
+	}
+
+	stats, err := r.GetLeagueStats(ctx, leagueID, playerID)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := stats.MetricValue(metric)
+	if !ok {
+		return 0, nil // nothing to rank the player on yet
+	}
+
+	var ahead int64
+	err = r.db.WithContext(ctx).
+		Model(&LeagueStats{}).
+		Where("league_id = ?", leagueID).
+		Where(leaderboard.ranked).
+		Where(clause.Gt{Column: clause.Column{Name: leaderboard.column}, Value: value}).
+		Count(&ahead).Error
+	if err != nil {
+		return 0, err
+	}
+	return int(ahead) + 1, nil
+}
+
+// toLeagueStatsEntities converts GORM models to repository structs
+func toLeagueStatsEntities(models []LeagueStats) []*repositories.LeagueStats {
+	stats := make([]*repositories.LeagueStats, len(models))
+	for i, model := range models {
+		stats[i] = ToLeagueStatsEntity(&model)
+	}
+	return stats
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+type throwRepository struct {
+	db *DB
+}
+
+func NewThrowRepository(db *DB) repositories.ThrowRepository {
+	return &throwRepository{db: db}
+}
+
+func (r *throwRepository) Create(ctx context.Context, throw *entities.Throw) error {
+	model := ToThrowModel(throw)
+	return r.db.WithContext(ctx).Create(model).Error
+}
+
+func (r *throwRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&Throw{}, "id = ?", id).Error
+}
+
+func (r *throwRepository) DeleteByGameID(ctx context.Context, gameID uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&Throw{}, "game_id = ?", gameID).Error
+}
+
+func (r *throwRepository) GetByGameID(ctx context.Context, gameID uuid.UUID) ([]*entities.Throw, error) {
+	var models []Throw
+	err := r.db.WithContext(ctx).
+		Where("game_id = ?", gameID).
+		Order("turn_number, throw_number").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	throws := make([]*entities.Throw, len(models))
+	for i, model := range models {
+		throws[i] = ToThrowEntity(&model)
+	}
+	return throws, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type tournamentRepository struct {
@@ -35,6 +36,20 @@ func (r *tournamentRepository) GetByID(ctx context.Context, id uuid.UUID) (*enti
 	return ToTournamentEntity(&model), nil
 }
 
+func (r *tournamentRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*entities.Tournament, error) {
+	var model Tournament
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&model, "id = ?", id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entities.ErrTournamentNotFound
+		}
+		return nil, err
+	}
+	return ToTournamentEntity(&model), nil
+}
+
 func (r *tournamentRepository) Update(ctx context.Context, tournament *entities.Tournament) error {
 	model := ToTournamentModel(tournament)
 	return r.db.WithContext(ctx).Save(model).Error
@@ -94,6 +109,43 @@ func (r *tournamentRepository) AddPlayer(ctx context.Context, tournamentID, play
 	return r.db.WithContext(ctx).Create(tournamentPlayer).Error
 }
 
+// SetPlayerPosition records an entrant's final position and the league points it earned
+func (r *tournamentRepository) SetPlayerPosition(ctx context.Context, tournamentID, playerID uuid.UUID, position int, points int) error {
+	return r.db.WithContext(ctx).
+		Model(&TournamentPlayer{}).
+		Where("tournament_id = ? AND player_id = ?", tournamentID, playerID).
+		Updates(map[string]interface{}{
+			"final_position": position,
+			"points_earned":  points,
+		}).Error
+}
+
+// SetPlayerSeed records an entrant's seed
+func (r *tournamentRepository) SetPlayerSeed(ctx context.Context, tournamentID, playerID uuid.UUID, seed int) error {
+	return r.db.WithContext(ctx).
+		Model(&TournamentPlayer{}).
+		Where("tournament_id = ? AND player_id = ?", tournamentID, playerID).
+		Update("seed", seed).Error
+}
+
+// GetPlayers returns the tournament entrants, seeded players first in seed order
+func (r *tournamentRepository) GetPlayers(ctx context.Context, tournamentID uuid.UUID) ([]*repositories.TournamentPlayer, error) {
+	var models []TournamentPlayer
+	err := r.db.WithContext(ctx).
+		Where("tournament_id = ?", tournamentID).
+		Order("seed ASC NULLS LAST, joined_at ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	players := make([]*repositories.TournamentPlayer, len(models))
+	for i, model := range models {
+		players[i] = ToTournamentPlayerEntity(&model)
+	}
+	return players, nil
+}
+
 func (r *tournamentRepository) IsPlayerInTournament(ctx context.Context, tournamentID, playerID uuid.UUID) (bool, error) {
 	var count int64
 	err := r.db.WithContext(ctx).
@@ -144,7 +196,6 @@ func (r *tournamentRepository) GetCompletedTournaments(ctx context.Context, leag
 // Add stubs for other required methods
 func (r *tournamentRepository) RemovePlayer(ctx context.Context, tournamentID, playerID uuid.UUID) error { return nil }
 func (r *tournamentRepository) GetTournamentPlayerCount(ctx context.Context, tournamentID uuid.UUID) (int, error) { return 0, nil }
-func (r *tournamentRepository) SetPlayerPosition(ctx context.Context, tournamentID, playerID uuid.UUID, position int, points int) error { return nil }
 func (r *tournamentRepository) GetTournamentsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entities.Tournament, error) { return nil, nil }
 func (r *tournamentRepository) GetTournamentsScheduledFor(ctx context.Context, date time.Time) ([]*entities.Tournament, error) { return nil, nil }
 func (r *tournamentRepository) GetUpcomingTournaments(ctx context.Context, limit int) ([]*entities.Tournament, error) { return nil, nil }
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/repositories"
+)
+
+// unitOfWork runs repository operations inside a single database transaction
+type unitOfWork struct {
+	tx *DB
+}
+
+func newUnitOfWork(db *DB) (repositories.UnitOfWork, error) {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return &unitOfWork{tx: &DB{DB: tx, sqlDB: db.sqlDB}}, nil
+}
+
+func (u *unitOfWork) Players() repositories.PlayerRepository {
+	return NewPlayerRepository(u.tx)
+}
+
+func (u *unitOfWork) Leagues() repositories.LeagueRepository {
+	return NewLeagueRepository(u.tx)
+}
+
+func (u *unitOfWork) Tournaments() repositories.TournamentRepository {
+	return NewTournamentRepository(u.tx)
+}
+
+func (u *unitOfWork) Matches() repositories.MatchRepository {
+	return NewMatchRepository(u.tx)
+}
+
+func (u *unitOfWork) Games() repositories.GameRepository {
+	return NewGameRepository(u.tx)
+}
+
+func (u *unitOfWork) Throws() repositories.ThrowRepository {
+	return NewThrowRepository(u.tx)
+}
+
+func (u *unitOfWork) ScoringEvents() repositories.ScoringEventRepository {
+	return NewScoringEventRepository(u.tx)
+}
+
+func (u *unitOfWork) Standings() repositories.LeagueStandingsRepository {
+	return NewLeagueStandingsRepository(u.tx)
+}
+
+func (u *unitOfWork) Statistics() repositories.StatisticsRepository {
+	return NewStatisticsRepository(u.tx)
+}
+
+func (u *unitOfWork) Commit(ctx context.Context) error {
+	return u.tx.WithContext(ctx).Commit().Error
+}
+
+func (u *unitOfWork) Rollback(ctx context.Context) error {
+	return u.tx.WithContext(ctx).Rollback().Error
+}
@@ -0,0 +1,342 @@
+package usecases
+
+import (
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// bracket holds a tournament's matches in memory while players are routed
+// between them, and remembers which matches were modified
+type bracket struct {
+	ordered []*entities.Match
+	matches map[uuid.UUID]*entities.Match
+	changed []*entities.Match
+	touched map[uuid.UUID]bool
+}
+
+func newBracket(matches []*entities.Match) *bracket {
+	b := &bracket{
+		ordered: matches,
+		matches: make(map[uuid.UUID]*entities.Match, len(matches)),
+		touched: make(map[uuid.UUID]bool),
+	}
+	for _, match := range matches {
+		b.matches[match.ID] = match
+	}
+	return b
+}
+
+// replace swaps in an updated copy of one of the bracket's matches
+func (b *bracket) replace(match *entities.Match) {
+	for i, existing := range b.ordered {
+		if existing.ID == match.ID {
+			b.ordered[i] = match
+		}
+	}
+	b.matches[match.ID] = match
+}
+
+// touch records a match as modified
+func (b *bracket) touch(match *entities.Match) {
+	if !b.touched[match.ID] {
+		b.touched[match.ID] = true
+		b.changed = append(b.changed, match)
+	}
+}
+
+// advance routes the winner and, in double elimination, the loser of a
+// completed match into their downstream matches
+func (b *bracket) advance(match *entities.Match) error {
+	if match.WinnerID != nil && match.NextMatchID != nil {
+		if err := b.place(*match.NextMatchID, match.NextMatchSlot, *match.WinnerID); err != nil {
+			return err
+		}
+	}
+
+	if loserID := match.LoserID(); loserID != nil && match.LoserNextMatchID != nil {
+		if err := b.place(*match.LoserNextMatchID, match.LoserNextMatchSlot, *loserID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// place puts a player into a match slot, completing the match straight away
+// when it is a bye
+func (b *bracket) place(matchID uuid.UUID, slot int, playerID uuid.UUID) error {
+	match, ok := b.matches[matchID]
+	if !ok {
+		return entities.ErrMatchNotFound
+	}
+
+	if err := match.AssignSlot(slot, playerID); err != nil {
+		return err
+	}
+	b.touch(match)
+
+	if !match.IsBye {
+		return nil
+	}
+	if err := match.CompleteWithBye(playerID); err != nil {
+		return err
+	}
+	return b.advance(match)
+}
+
+// resolveByes flags matches that can receive fewer than two players, then
+// completes the byes whose player is already known. It is used once, while
+// the bracket is being built.
+func (b *bracket) resolveByes() error {
+	feeders := make(map[uuid.UUID][]*entities.Match)
+	for _, match := range b.ordered {
+		if match.NextMatchID != nil {
+			feeders[*match.NextMatchID] = append(feeders[*match.NextMatchID], match)
+		}
+		if match.LoserNextMatchID != nil {
+			feeders[*match.LoserNextMatchID] = append(feeders[*match.LoserNextMatchID], match)
+		}
+	}
+
+	entrants := make(map[uuid.UUID]int, len(b.ordered))
+	var count func(match *entities.Match) int
+	count = func(match *entities.Match) int {
+		if n, ok := entrants[match.ID]; ok {
+			return n
+		}
+		n := 0
+		if match.Player1ID != nil {
+			n++
+		}
+		if match.Player2ID != nil {
+			n++
+		}
+		for _, feeder := range feeders[match.ID] {
+			fed := count(feeder)
+			if feeder.NextMatchID != nil && *feeder.NextMatchID == match.ID && fed >= 1 {
+				n++
+			}
+			if feeder.LoserNextMatchID != nil && *feeder.LoserNextMatchID == match.ID && fed == 2 {
+				n++
+			}
+		}
+		entrants[match.ID] = n
+		return n
+	}
+
+	for _, match := range b.ordered {
+		switch count(match) {
+		case 0:
+			match.MarkEmpty()
+		case 1:
+			match.MarkBye()
+		}
+	}
+
+	for _, match := range b.ordered {
+		if !match.IsBye || !match.IsWaiting() {
+			continue
+		}
+		playerID := match.Player1ID
+		if playerID == nil {
+			playerID = match.Player2ID
+		}
+		if playerID == nil {
+			continue
+		}
+		if err := match.CompleteWithBye(*playerID); err != nil {
+			return err
+		}
+		if err := b.advance(match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bracketSize returns the smallest power of two that fits the given field
+func bracketSize(players int) int {
+	size := 1
+	for size < players {
+		size *= 2
+	}
+	return size
+}
+
+// bracketSeedOrder returns the seed that occupies each bracket line so that
+// the top seeds can only meet in the latest rounds (1 v 16, 8 v 9, ...)
+func bracketSeedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		total := len(order)*2 + 1
+		for _, seed := range order {
+			next = append(next, seed, total-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// buildSingleEliminationBracket creates every match of a single-elimination
+// bracket. Players must be ordered by seed; missing seeds become byes whose
+// opponents are advanced straight into the second round.
+func buildSingleEliminationBracket(tournamentID uuid.UUID, players []uuid.UUID) ([]*entities.Match, error) {
+	size := bracketSize(len(players))
+	rounds := buildEmptyBracket(tournamentID, size, entities.BracketSideWinners)
+
+	if err := seedFirstRound(rounds[0], players); err != nil {
+		return nil, err
+	}
+
+	matches := flattenRounds(rounds)
+	if err := newBracket(matches).resolveByes(); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// buildDoubleEliminationBracket creates the winners bracket, the losers
+// bracket and the grand final. A bracket-reset match is only created if the
+// losers-bracket player wins the first grand final.
+func buildDoubleEliminationBracket(tournamentID uuid.UUID, players []uuid.UUID) ([]*entities.Match, error) {
+	size := bracketSize(len(players))
+	winners := buildEmptyBracket(tournamentID, size, entities.BracketSideWinners)
+	losers := buildLosersBracket(tournamentID, size)
+
+	grandFinal := entities.NewMatch(tournamentID, 1, 1)
+	grandFinal.BracketSide = entities.BracketSideGrandFinal
+	winners[len(winners)-1][0].LinkTo(grandFinal, 1)
+	if len(losers) > 0 {
+		losers[len(losers)-1][0].LinkTo(grandFinal, 2)
+	}
+
+	// Winners-bracket losers drop into the losers bracket. First-round losers
+	// meet each other; later losers enter against losers-bracket survivors in
+	// an order that keeps early opponents apart.
+	for r, round := range winners {
+		for i, match := range round {
+			switch {
+			case len(losers) == 0:
+				match.LinkLoserTo(grandFinal, 2)
+			case r == 0:
+				match.LinkLoserTo(losers[0][i/2], i%2+1)
+			default:
+				target := losers[2*r-1]
+				match.LinkLoserTo(target[dropPosition(r+1, i, len(target))], 2)
+			}
+		}
+	}
+
+	if err := seedFirstRound(winners[0], players); err != nil {
+		return nil, err
+	}
+
+	matches := flattenRounds(winners)
+	matches = append(matches, flattenRounds(losers)...)
+	matches = append(matches, grandFinal)
+	if err := newBracket(matches).resolveByes(); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// buildEmptyBracket creates the match tree for a bracket of the given size,
+// linking every match to the one its winner advances to
+func buildEmptyBracket(tournamentID uuid.UUID, size int, side entities.BracketSide) [][]*entities.Match {
+	var rounds [][]*entities.Match
+	for matchCount, round := size/2, 1; matchCount >= 1; matchCount, round = matchCount/2, round+1 {
+		matches := make([]*entities.Match, matchCount)
+		for i := range matches {
+			matches[i] = entities.NewMatch(tournamentID, round, i+1)
+			matches[i].BracketSide = side
+		}
+		rounds = append(rounds, matches)
+	}
+
+	for r := 0; r < len(rounds)-1; r++ {
+		for i, match := range rounds[r] {
+			match.LinkTo(rounds[r+1][i/2], i%2+1)
+		}
+	}
+	return rounds
+}
+
+// buildLosersBracket creates the losers bracket for a winners bracket of the
+// given size. Odd rounds play losers-bracket survivors against each other
+// (round 1 is the winners-bracket first-round losers), even rounds bring in
+// the losers of the next winners-bracket round.
+func buildLosersBracket(tournamentID uuid.UUID, size int) [][]*entities.Match {
+	var rounds [][]*entities.Match
+	for matchCount, round := size/4, 1; matchCount >= 1; round++ {
+		matches := make([]*entities.Match, matchCount)
+		for i := range matches {
+			matches[i] = entities.NewMatch(tournamentID, round, i+1)
+			matches[i].BracketSide = entities.BracketSideLosers
+		}
+		rounds = append(rounds, matches)
+		if round%2 == 0 {
+			matchCount /= 2
+		}
+	}
+
+	for r := 0; r < len(rounds)-1; r++ {
+		for i, match := range rounds[r] {
+			if len(rounds[r+1]) == len(rounds[r]) {
+				match.LinkTo(rounds[r+1][i], 1)
+			} else {
+				match.LinkTo(rounds[r+1][i/2], i%2+1)
+			}
+		}
+	}
+	return rounds
+}
+
+// dropPosition returns the losers-bracket match a winners-bracket loser drops
+// into. Alternating between reversed and half-swapped order avoids immediate
+// rematches between players who met earlier.
+func dropPosition(winnersRound, index, count int) int {
+	if count == 1 {
+		return 0
+	}
+	if winnersRound%2 == 0 {
+		return count - 1 - index
+	}
+	return (index + count/2) % count
+}
+
+// seedFirstRound places the seeded players into standard bracket positions;
+// missing seeds leave the slot empty for a bye
+func seedFirstRound(round []*entities.Match, players []uuid.UUID) error {
+	order := bracketSeedOrder(len(round) * 2)
+	for i, match := range round {
+		for slot, seed := range []int{order[i*2], order[i*2+1]} {
+			if seed > len(players) {
+				continue
+			}
+			if err := match.AssignSlot(slot+1, players[seed-1]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// flattenRounds returns all bracket matches ordered by round and match number
+func flattenRounds(rounds [][]*entities.Match) []*entities.Match {
+	var matches []*entities.Match
+	for _, round := range rounds {
+		matches = append(matches, round...)
+	}
+	return matches
+}
+
+// seededPlayerIDs returns the entrants' IDs in seed order
+func seededPlayerIDs(players []*repositories.TournamentPlayer) []uuid.UUID {
+	ids := make([]uuid.UUID, len(players))
+	for i, player := range players {
+		ids[i] = player.PlayerID
+	}
+	return ids
+}
@@ -0,0 +1,204 @@
+package usecases
+
+import (
+	"testing"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+var bracketFieldSizes = []int{2, 3, 5, 8, 16}
+
+// seedRanks maps each player to their seed, 1 being the top seed
+func seedRanks(players []uuid.UUID) map[uuid.UUID]int {
+	seeds := make(map[uuid.UUID]int, len(players))
+	for i, playerID := range players {
+		seeds[playerID] = i + 1
+	}
+	return seeds
+}
+
+// playBracket plays every match of a bracket as players reach it, the
+// better seed always winning, and returns how many matches each player lost
+func playBracket(t *testing.T, matches []*entities.Match, seeds map[uuid.UUID]int) map[uuid.UUID]int {
+	t.Helper()
+	b := newBracket(matches)
+	losses := make(map[uuid.UUID]int)
+	for played := true; played; {
+		played = false
+		for _, match := range matches {
+			if match.IsBye || !match.IsWaiting() || match.Player1ID == nil || match.Player2ID == nil {
+				continue
+			}
+			winnerID, loserID := *match.Player1ID, *match.Player2ID
+			if seeds[loserID] < seeds[winnerID] {
+				winnerID, loserID = loserID, winnerID
+			}
+			playMatch(t, match, winnerID)
+			losses[loserID]++
+			if err := b.advance(match); err != nil {
+				t.Fatalf("advance from %s round %d match %d: %v", match.BracketSide, match.Round, match.MatchNumber, err)
+			}
+			played = true
+		}
+	}
+
+	for _, match := range matches {
+		if match.Status != entities.MatchStatusCompleted {
+			t.Errorf("%s round %d match %d left %s", match.BracketSide, match.Round, match.MatchNumber, match.Status)
+		}
+	}
+	return losses
+}
+
+// bracketMatch finds a bracket match by side, round and number
+func bracketMatch(t *testing.T, matches []*entities.Match, side entities.BracketSide, round, number int) *entities.Match {
+	t.Helper()
+	for _, match := range matches {
+		if match.BracketSide == side && match.Round == round && match.MatchNumber == number {
+			return match
+		}
+	}
+	t.Fatalf("no %s round %d match %d", side, round, number)
+	return nil
+}
+
+func TestBracketSeedOrder(t *testing.T) {
+	want := []int{1, 8, 4, 5, 2, 7, 3, 6}
+	got := bracketSeedOrder(8)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("bracketSeedOrder(8) = %v, want %v", got, want)
+		}
+	}
+
+	for _, size := range []int{2, 4, 16, 32} {
+		order := bracketSeedOrder(size)
+		for i := 0; i < size; i += 2 {
+			if order[i]+order[i+1] != size+1 {
+				t.Errorf("size %d: first-round pairing %d v %d", size, order[i], order[i+1])
+			}
+		}
+	}
+}
+
+func TestSingleEliminationBracket(t *testing.T) {
+	for _, n := range bracketFieldSizes {
+		players := newPlayerIDs(n)
+		seeds := seedRanks(players)
+		size := bracketSize(n)
+
+		matches, err := buildSingleEliminationBracket(uuid.New(), players)
+		if err != nil {
+			t.Fatalf("%d players: %v", n, err)
+		}
+		if len(matches) != size-1 {
+			t.Errorf("%d players: %d matches, want %d", n, len(matches), size-1)
+		}
+
+		// The top seeds get the byes
+		byes := 0
+		for _, match := range matches {
+			if match.Round != 1 || !match.IsBye {
+				continue
+			}
+			byes++
+			if match.WinnerID == nil || seeds[*match.WinnerID] > size-n {
+				t.Errorf("%d players: bye in match %d not given to a top seed", n, match.MatchNumber)
+			}
+		}
+		if byes != size-n {
+			t.Errorf("%d players: %d byes, want %d", n, byes, size-n)
+		}
+
+		losses := playBracket(t, matches, seeds)
+		final := matches[len(matches)-1]
+		if final.WinnerID == nil || seeds[*final.WinnerID] != 1 || seeds[*final.LoserID()] != 2 {
+			t.Errorf("%d players: final not seed 1 beating seed 2", n)
+		}
+		for _, playerID := range players {
+			want := 1
+			if seeds[playerID] == 1 {
+				want = 0
+			}
+			if losses[playerID] != want {
+				t.Errorf("%d players: seed %d lost %d matches, want %d", n, seeds[playerID], losses[playerID], want)
+			}
+		}
+	}
+}
+
+func TestDoubleEliminationBracket(t *testing.T) {
+	for _, n := range bracketFieldSizes {
+		players := newPlayerIDs(n)
+		seeds := seedRanks(players)
+		size := bracketSize(n)
+
+		matches, err := buildDoubleEliminationBracket(uuid.New(), players)
+		if err != nil {
+			t.Fatalf("%d players: %v", n, err)
+		}
+		// size-1 winners-bracket matches, size-2 losers-bracket matches and
+		// the grand final
+		if len(matches) != 2*size-2 {
+			t.Errorf("%d players: %d matches, want %d", n, len(matches), 2*size-2)
+		}
+
+		// Nobody is out until they have lost twice
+		losses := playBracket(t, matches, seeds)
+		for _, playerID := range players {
+			want := 2
+			if seeds[playerID] == 1 {
+				want = 0
+			}
+			if losses[playerID] != want {
+				t.Errorf("%d players: seed %d lost %d matches, want %d", n, seeds[playerID], losses[playerID], want)
+			}
+		}
+
+		grandFinal := bracketMatch(t, matches, entities.BracketSideGrandFinal, 1, 1)
+		if grandFinal.WinnerID == nil || seeds[*grandFinal.WinnerID] != 1 || seeds[*grandFinal.LoserID()] != 2 {
+			t.Errorf("%d players: grand final not seed 1 beating seed 2", n)
+		}
+	}
+}
+
+// Winners-bracket losers drop into the losers bracket in an order that keeps
+// players who may have met apart: first-round losers pair off in order, later
+// rounds drop in reversed
+func TestDoubleEliminationDropPositions(t *testing.T) {
+	type drop struct {
+		winnersRound, winnersMatch int
+		losersRound, losersMatch   int
+		slot                       int
+	}
+	tests := map[int][]drop{
+		8: {
+			{1, 1, 1, 1, 1}, {1, 2, 1, 1, 2}, {1, 3, 1, 2, 1}, {1, 4, 1, 2, 2},
+			{2, 1, 2, 2, 2}, {2, 2, 2, 1, 2},
+			{3, 1, 4, 1, 2},
+		},
+		16: {
+			{1, 1, 1, 1, 1}, {1, 2, 1, 1, 2}, {1, 7, 1, 4, 1}, {1, 8, 1, 4, 2},
+			{2, 1, 2, 4, 2}, {2, 2, 2, 3, 2}, {2, 3, 2, 2, 2}, {2, 4, 2, 1, 2},
+			{3, 1, 4, 2, 2}, {3, 2, 4, 1, 2},
+			{4, 1, 6, 1, 2},
+		},
+	}
+
+	for n, drops := range tests {
+		matches, err := buildDoubleEliminationBracket(uuid.New(), newPlayerIDs(n))
+		if err != nil {
+			t.Fatalf("%d players: %v", n, err)
+		}
+		for _, d := range drops {
+			from := bracketMatch(t, matches, entities.BracketSideWinners, d.winnersRound, d.winnersMatch)
+			to := bracketMatch(t, matches, entities.BracketSideLosers, d.losersRound, d.losersMatch)
+			if from.LoserNextMatchID == nil || *from.LoserNextMatchID != to.ID || from.LoserNextMatchSlot != d.slot {
+				t.Errorf("%d players: loser of winners round %d match %d should drop to losers round %d match %d slot %d",
+					n, d.winnersRound, d.winnersMatch, d.losersRound, d.losersMatch, d.slot)
+			}
+		}
+	}
+}
@@ -8,6 +8,8 @@ type UseCases struct {
 	League     *LeagueUseCase
 	Tournament *TournamentUseCase
 	Match      *MatchUseCase
+	Game       *GameUseCase
+	Statistics *StatisticsUseCase
 }
 
 // NewUseCases creates all use case instances
@@ -16,12 +18,19 @@ func NewUseCases(
 	leagueRepo repositories.LeagueRepository,
 	tournamentRepo repositories.TournamentRepository,
 	matchRepo repositories.MatchRepository,
+	gameRepo repositories.GameRepository,
+	throwRepo repositories.ThrowRepository,
+	scoringEventRepo repositories.ScoringEventRepository,
 	standingsRepo repositories.LeagueStandingsRepository,
+	statsRepo repositories.StatisticsRepository,
+	repoFactory repositories.RepositoryFactory,
 ) *UseCases {
 	return &UseCases{
 		Player:     NewPlayerUseCase(playerRepo),
 		League:     NewLeagueUseCase(leagueRepo, standingsRepo),
-		Tournament: NewTournamentUseCase(tournamentRepo, leagueRepo, matchRepo),
-		Match:      NewMatchUseCase(matchRepo, standingsRepo),
+		Tournament: NewTournamentUseCase(tournamentRepo, leagueRepo, matchRepo, repoFactory),
+		Match:      NewMatchUseCase(matchRepo, tournamentRepo, standingsRepo, repoFactory),
+		Game:       NewGameUseCase(gameRepo, throwRepo, scoringEventRepo, matchRepo, tournamentRepo, repoFactory),
+		Statistics: NewStatisticsUseCase(statsRepo, leagueRepo, tournamentRepo, playerRepo, matchRepo),
 	}
 }
\ No newline at end of file
@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"sort"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// finalPositions ranks every entrant of a finished tournament. Table formats
+// use the table order; in brackets players knocked out at the same stage share
+// a position, so both losing semi-finalists finish third.
+func finalPositions(tournament *entities.Tournament, players []uuid.UUID, matches []*entities.Match) map[uuid.UUID]int {
+	switch tournament.Type {
+	case entities.TournamentTypeRoundRobin, entities.TournamentTypeSwiss:
+		positions := make(map[uuid.UUID]int, len(players))
+		for _, row := range buildTable(players, matches, tournament.Tiebreakers) {
+			positions[row.PlayerID] = row.Position
+		}
+		return positions
+	case entities.TournamentTypeGroupKnockout:
+		return groupKnockoutPositions(tournament, matches)
+	}
+	return bracketPositions(matches)
+}
+
+// bracketPositions ranks the players of an elimination bracket by the stage
+// at which they were knocked out
+func bracketPositions(matches []*entities.Match) map[uuid.UUID]int {
+	stages := make(map[uuid.UUID]int)
+	var champion *uuid.UUID
+	finalStage := 0
+	for _, match := range matches {
+		if match.BracketSide == entities.BracketSideGroup || match.Status != entities.MatchStatusCompleted {
+			continue
+		}
+
+		stage := eliminationStage(match)
+		if match.WinnerID != nil && stage > finalStage {
+			champion, finalStage = match.WinnerID, stage
+		}
+
+		// Losers who drop into the losers bracket are still in the event
+		loserID := match.LoserID()
+		if loserID == nil || match.LoserNextMatchID != nil {
+			continue
+		}
+		if stage > stages[*loserID] {
+			stages[*loserID] = stage
+		}
+	}
+
+	positions := make(map[uuid.UUID]int, len(stages)+1)
+	if champion == nil {
+		return positions
+	}
+	delete(stages, *champion) // lost a grand final but won the reset
+	positions[*champion] = 1
+
+	for playerID, stage := range stages {
+		position := 2
+		for _, other := range stages {
+			if other > stage {
+				position++
+			}
+		}
+		positions[playerID] = position
+	}
+	return positions
+}
+
+// eliminationStage orders bracket matches so that later stages compare
+// higher: winners-bracket rounds, then losers-bracket rounds, then the grand
+// final and its reset
+func eliminationStage(match *entities.Match) int {
+	switch match.BracketSide {
+	case entities.BracketSideLosers:
+		return 1000 + match.Round
+	case entities.BracketSideGrandFinal:
+		return 2000 + match.Round
+	}
+	return match.Round
+}
+
+// groupKnockoutPositions ranks knockout players by their bracket result and
+// the players eliminated in the groups below them by group place
+func groupKnockoutPositions(tournament *entities.Tournament, matches []*entities.Match) map[uuid.UUID]int {
+	positions := bracketPositions(matches)
+	knockoutPlayers := len(positions)
+
+	type groupFinish struct {
+		playerID uuid.UUID
+		place    int
+	}
+	var eliminated []groupFinish
+	members := groupMembers(matches)
+	for _, number := range groupNumbers(members) {
+		for _, row := range buildTable(members[number], groupMatchesOf(matches, number), tournament.Tiebreakers) {
+			if _, ok := positions[row.PlayerID]; !ok {
+				eliminated = append(eliminated, groupFinish{playerID: row.PlayerID, place: row.Position})
+			}
+		}
+	}
+
+	sort.SliceStable(eliminated, func(i, j int) bool {
+		return eliminated[i].place < eliminated[j].place
+	})
+	for i, finish := range eliminated {
+		position := knockoutPlayers + i + 1
+		if i > 0 && finish.place == eliminated[i-1].place {
+			position = positions[eliminated[i-1].playerID]
+		}
+		positions[finish.playerID] = position
+	}
+	return positions
+}
+
+// eliminationRounds returns the elimination round each player reached, for
+// the finals and semi-finals counted in the league standings. Only bracket
+// players have one, found from their final position; table formats have no
+// finals, so their winner is the only player with a round.
+func eliminationRounds(tournament *entities.Tournament, positions map[uuid.UUID]int, matches []*entities.Match) map[uuid.UUID]string {
+	rounds := make(map[uuid.UUID]string, len(positions))
+	switch tournament.Type {
+	case entities.TournamentTypeRoundRobin, entities.TournamentTypeSwiss:
+		for playerID, position := range positions {
+			if position == 1 {
+				rounds[playerID] = entities.RoundWinner
+			}
+		}
+	case entities.TournamentTypeGroupKnockout:
+		// Players knocked out in the groups never reached the bracket
+		for playerID := range bracketPositions(matches) {
+			rounds[playerID] = entities.EliminationRound(positions[playerID])
+		}
+	default:
+		for playerID, position := range positions {
+			rounds[playerID] = entities.EliminationRound(position)
+		}
+	}
+	return rounds
+}
+
+// matchWins counts the matches each player won on the board; byes do not count
+func matchWins(matches []*entities.Match) map[uuid.UUID]int {
+	wins := make(map[uuid.UUID]int)
+	for _, match := range matches {
+		if match.IsDecided() && match.WinnerID != nil {
+			wins[*match.WinnerID]++
+		}
+	}
+	return wins
+}
@@ -0,0 +1,115 @@
+package usecases
+
+import (
+	"testing"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// newPlayerIDs returns n player IDs in seed order
+func newPlayerIDs(n int) []uuid.UUID {
+	players := make([]uuid.UUID, n)
+	for i := range players {
+		players[i] = uuid.New()
+	}
+	return players
+}
+
+// playMatch starts a match and completes it with the given winner
+func playMatch(t *testing.T, match *entities.Match, winnerID uuid.UUID) {
+	t.Helper()
+	if err := match.StartMatch(); err != nil {
+		t.Fatalf("start match %d/%d: %v", match.Round, match.MatchNumber, err)
+	}
+	if err := match.CompleteMatch(winnerID); err != nil {
+		t.Fatalf("complete match %d/%d: %v", match.Round, match.MatchNumber, err)
+	}
+}
+
+func TestEliminationRounds(t *testing.T) {
+	players := newPlayerIDs(5)
+	a, b, c, d, e := players[0], players[1], players[2], players[3], players[4]
+
+	tests := []struct {
+		name      string
+		typ       entities.TournamentType
+		positions map[uuid.UUID]int
+		matches   func() []*entities.Match
+		want      map[uuid.UUID]string
+	}{
+		{
+			name:      "round robin has only a winner",
+			typ:       entities.TournamentTypeRoundRobin,
+			positions: map[uuid.UUID]int{a: 1, b: 2, c: 3, d: 4},
+			want:      map[uuid.UUID]string{a: entities.RoundWinner},
+		},
+		{
+			name:      "swiss has only a winner",
+			typ:       entities.TournamentTypeSwiss,
+			positions: map[uuid.UUID]int{a: 1, b: 2, c: 3},
+			want:      map[uuid.UUID]string{a: entities.RoundWinner},
+		},
+		{
+			name:      "single elimination rounds follow positions",
+			typ:       entities.TournamentTypeSingleElimination,
+			positions: map[uuid.UUID]int{a: 1, b: 2, c: 3, d: 3, e: 5},
+			want: map[uuid.UUID]string{
+				a: entities.RoundWinner,
+				b: entities.RoundFinal,
+				c: entities.RoundSemiFinal,
+				d: entities.RoundSemiFinal,
+				e: entities.RoundQuarterFinal,
+			},
+		},
+		{
+			name:      "group knockout players out in the groups reach no round",
+			typ:       entities.TournamentTypeGroupKnockout,
+			positions: map[uuid.UUID]int{a: 1, b: 2, c: 3, d: 3},
+			matches: func() []*entities.Match {
+				final := entities.NewMatch(uuid.Nil, 1, 1)
+				if err := final.SetPlayers(a, b); err != nil {
+					t.Fatal(err)
+				}
+				playMatch(t, final, a)
+				return []*entities.Match{final}
+			},
+			want: map[uuid.UUID]string{a: entities.RoundWinner, b: entities.RoundFinal},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var matches []*entities.Match
+			if tt.matches != nil {
+				matches = tt.matches()
+			}
+			got := eliminationRounds(&entities.Tournament{Type: tt.typ}, tt.positions, matches)
+			for playerID := range tt.positions {
+				if got[playerID] != tt.want[playerID] {
+					t.Errorf("position %d: round %q, want %q", tt.positions[playerID], got[playerID], tt.want[playerID])
+				}
+			}
+		})
+	}
+}
+
+// Table finishers below the winner reached no knockout round, so the
+// default schedule's final and semi-final points are not theirs
+func TestRoundRobinPlacesEarnNoRoundPoints(t *testing.T) {
+	players := newPlayerIDs(5)
+	positions := make(map[uuid.UUID]int, len(players))
+	for i, playerID := range players {
+		positions[playerID] = i + 1
+	}
+	league := &entities.League{PointsSchedule: entities.DefaultPointsSchedule()}
+
+	rounds := eliminationRounds(&entities.Tournament{Type: entities.TournamentTypeRoundRobin}, positions, nil)
+	want := []int{3, 0, 0, 0, 0}
+	for i, playerID := range players {
+		if got := league.TournamentPoints(positions[playerID], rounds[playerID], 0); got != want[i] {
+			t.Errorf("position %d: %d points, want %d", i+1, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package usecases
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// LegResult is a leg after a change, together with its match, whose score has
+// been updated from the legs, and any darts recorded by the change
+type LegResult struct {
+	Leg    *entities.Game    `json:"leg"`
+	Match  *entities.Match   `json:"match"`
+	Throws []*entities.Throw `json:"throws,omitempty"`
+}
+
+// LegState is a leg as shown on a scoreboard: scores, cricket marks in target
+// order and, while the leg is in progress, who throws next and, in X01, the
+// route to finish their remaining score
+type LegState struct {
+	Leg               *entities.Game     `json:"leg"`
+	Targets           []int              `json:"targets,omitempty"`
+	NextThrowerID     *uuid.UUID         `json:"next_thrower_id,omitempty"`
+	TurnNumber        int                `json:"turn_number,omitempty"`
+	DartNumber        int                `json:"dart_number,omitempty"`
+	SuggestedCheckout *entities.Checkout `json:"suggested_checkout,omitempty"`
+}
+
+type GameUseCase struct {
+	gameRepo         repositories.GameRepository
+	throwRepo        repositories.ThrowRepository
+	scoringEventRepo repositories.ScoringEventRepository
+	matchRepo        repositories.MatchRepository
+	tournamentRepo   repositories.TournamentRepository
+	repoFactory      repositories.RepositoryFactory
+}
+
+func NewGameUseCase(
+	gameRepo repositories.GameRepository,
+	throwRepo repositories.ThrowRepository,
+	scoringEventRepo repositories.ScoringEventRepository,
+	matchRepo repositories.MatchRepository,
+	tournamentRepo repositories.TournamentRepository,
+	repoFactory repositories.RepositoryFactory,
+) *GameUseCase {
+	return &GameUseCase{
+		gameRepo:         gameRepo,
+		throwRepo:        throwRepo,
+		scoringEventRepo: scoringEventRepo,
+		matchRepo:        matchRepo,
+		tournamentRepo:   tournamentRepo,
+		repoFactory:      repoFactory,
+	}
+}
+
+// GetMatchLegs retrieves the legs of a match in the order they were played
+func (uc *GameUseCase) GetMatchLegs(ctx context.Context, matchID uuid.UUID) ([]*entities.Game, error) {
+	if _, err := uc.matchRepo.GetByID(ctx, matchID); err != nil {
+		return nil, err
+	}
+	return uc.gameRepo.GetByMatchID(ctx, matchID)
+}
+
+// StartLeg starts the next leg of an ongoing match. Unless a first thrower is
+// given, the throw alternates from the previous leg; the first leg is opened
+// by the winner of the recorded throw-off, or otherwise by player 1.
+func (uc *GameUseCase) StartLeg(ctx context.Context, matchID uuid.UUID, firstThrowerID *uuid.UUID) (*entities.Game, error) {
+	var leg *entities.Game
+	err := runInTransaction(ctx, uc.repoFactory, func(uow repositories.UnitOfWork) error {
+		match, err := uow.Matches().GetByID(ctx, matchID)
+		if err != nil {
+			return err
+		}
+		if match.Status != entities.MatchStatusInProgress {
+			return entities.ErrMatchNotInProgress
+		}
+
+		var tournament *entities.Tournament
+		if match.TournamentID != uuid.Nil {
+			tournament, err = uow.Tournaments().GetByID(ctx, match.TournamentID)
+			if err != nil {
+				return err
+			}
+		}
+
+		legs, err := uow.Games().GetByMatchID(ctx, match.ID)
+		if err != nil {
+			return err
+		}
+		tally := tallyLegs(match, legs, tournament)
+		if tally.inProgress {
+			return entities.ErrLegInProgress
+		}
+
+		// Work out who throws first
+		thrower := *match.Player1ID
+		switch {
+		case firstThrowerID != nil:
+			if _, err := match.GetOpponent(*firstThrowerID); err != nil {
+				return err
+			}
+			thrower = *firstThrowerID
+		case tally.lastThrower != nil:
+			opponent, err := match.GetOpponent(*tally.lastThrower)
+			if err != nil {
+				return err
+			}
+			thrower = *opponent
+		case match.ThrowOffWinnerID != nil:
+			thrower = *match.ThrowOffWinnerID
+		}
+
+		rules, err := legRules(match, tournament)
+		if err != nil {
+			return err
+		}
+		leg = entities.NewGame(match.ID, tally.set, tally.leg, thrower, rules)
+		return uow.Games().Create(ctx, leg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return leg, nil
+}
+
+// CompleteLeg records the winner of a leg and recalculates the match score
+// from the completed legs. Once the score decides the match, the match is
+// completed and its tournament progresses.
+func (uc *GameUseCase) CompleteLeg(ctx context.Context, matchID, legID, winnerID uuid.UUID) (*LegResult, error) {
+	var leg *entities.Game
+	match, err := updateMatch(ctx, uc.repoFactory, matchID, func(uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament) error {
+		var err error
+		leg, err = uow.Games().GetByID(ctx, legID)
+		if err != nil {
+			return err
+		}
+		if leg.MatchID != match.ID {
+			return entities.ErrLegNotFound
+		}
+		if match.Status != entities.MatchStatusInProgress {
+			return entities.ErrMatchNotInProgress
+		}
+		if _, err := match.GetOpponent(winnerID); err != nil {
+			return entities.ErrInvalidWinner
+		}
+
+		// Complete leg
+		if err := leg.Complete(winnerID); err != nil {
+			return err
+		}
+		if err := uow.Games().Update(ctx, leg); err != nil {
+			return err
+		}
+		event := entities.NewScoringEvent(leg.ID, entities.ScoringEventCompleteLeg, nil)
+		event.WinnerID = &winnerID
+		if err := appendScoringEvent(ctx, uow, event); err != nil {
+			return err
+		}
+
+		return scoreMatchFromLegs(ctx, uow, match, tournament)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LegResult{Leg: leg, Match: match}, nil
+}
+
+// GetLegState retrieves a leg with who throws next, for the scoreboard
+func (uc *GameUseCase) GetLegState(ctx context.Context, legID uuid.UUID) (*LegState, error) {
+	leg, err := uc.gameRepo.GetByID(ctx, legID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &LegState{Leg: leg}
+	if leg.Player1Marks != nil || leg.Player2Marks != nil {
+		state.Targets = entities.CricketTargets
+	}
+	if leg.IsCompleted() {
+		return state, nil
+	}
+
+	match, err := uc.matchRepo.GetByID(ctx, leg.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	throws, err := uc.throwRepo.GetByGameID(ctx, leg.ID)
+	if err != nil {
+		return nil, err
+	}
+	playerID, turn, number, err := leg.NextDart(match, throws)
+	if err != nil {
+		return nil, err
+	}
+	state.NextThrowerID = &playerID
+	state.TurnNumber = turn
+	state.DartNumber = number
+
+	var tournament *entities.Tournament
+	if match.TournamentID != uuid.Nil {
+		tournament, err = uc.tournamentRepo.GetByID(ctx, match.TournamentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if legGameType(match, tournament).IsX01() {
+		dartsLeft := entities.DartsPerTurn - number + 1
+		state.SuggestedCheckout = entities.SuggestCheckout(leg.PlayerScore(match, playerID), dartsLeft, legX01Rules(match, tournament).OutRule)
+	}
+
+	return state, nil
+}
+
+// SuggestCheckout returns the preferred route to finish a remaining score
+// with the darts left in the visit; an empty out-rule means double out
+func (uc *GameUseCase) SuggestCheckout(remaining, dartsLeft int, outRule entities.CheckRule) *entities.Checkout {
+	if outRule == "" {
+		outRule = entities.DefaultX01Rules.OutRule
+	}
+	return entities.SuggestCheckout(remaining, dartsLeft, outRule)
+}
+
+// GetLegThrows retrieves the darts thrown in a leg
+func (uc *GameUseCase) GetLegThrows(ctx context.Context, legID uuid.UUID) ([]*entities.Throw, error) {
+	if _, err := uc.gameRepo.GetByID(ctx, legID); err != nil {
+		return nil, err
+	}
+	return uc.throwRepo.GetByGameID(ctx, legID)
+}
+
+// ThrowDarts scores darts in a leg under its game's rules in the order they
+// were thrown and records every one, along with an entry in the leg's scoring
+// log. The thrower is worked out from the turns so far. A winning dart
+// completes the leg, which updates the match score and may complete the
+// match and progress its tournament.
+func (uc *GameUseCase) ThrowDarts(ctx context.Context, legID uuid.UUID, darts []entities.Dart, recordedBy *string) (*LegResult, error) {
+	event := entities.NewScoringEvent(legID, entities.ScoringEventDarts, recordedBy)
+	event.Darts = darts
+	return uc.recordScoring(ctx, event)
+}
+
+// ScoreVisit scores the next visit of an X01 leg from its three-dart total,
+// for matches entered visit by visit rather than dart by dart. A checkout
+// records how many darts it took.
+func (uc *GameUseCase) ScoreVisit(ctx context.Context, legID uuid.UUID, visit entities.Visit, recordedBy *string) (*LegResult, error) {
+	event := entities.NewScoringEvent(legID, entities.ScoringEventVisit, recordedBy)
+	event.Visit = &visit
+	return uc.recordScoring(ctx, event)
+}
+
+// recordScoring scores a darts or visit event on top of the leg's throws so
+// far and appends it to the scoring log
+func (uc *GameUseCase) recordScoring(ctx context.Context, event *entities.ScoringEvent) (*LegResult, error) {
+	leg, err := uc.gameRepo.GetByID(ctx, event.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var throws []*entities.Throw
+	match, err := updateMatch(ctx, uc.repoFactory, leg.MatchID, func(uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament) error {
+		var err error
+		leg, err = uow.Games().GetByID(ctx, event.GameID)
+		if err != nil {
+			return err
+		}
+		if match.Status != entities.MatchStatusInProgress {
+			return entities.ErrMatchNotInProgress
+		}
+		previous, err := uow.Throws().GetByGameID(ctx, leg.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range eventEntries(event) {
+			throw, err := scoreEntry(leg, match, previous, entry, tournament)
+			if err != nil {
+				return err
+			}
+			if err := uow.Throws().Create(ctx, throw); err != nil {
+				return err
+			}
+			previous = append(previous, throw)
+			throws = append(throws, throw)
+		}
+
+		if err := uow.Games().Update(ctx, leg); err != nil {
+			return err
+		}
+		if err := appendScoringEvent(ctx, uow, event); err != nil {
+			return err
+		}
+		if !leg.IsCompleted() {
+			return nil
+		}
+		return scoreMatchFromLegs(ctx, uow, match, tournament)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LegResult{Leg: leg, Match: match, Throws: throws}, nil
+}
+
+// GetLegEvents retrieves a leg's scoring log
+func (uc *GameUseCase) GetLegEvents(ctx context.Context, legID uuid.UUID) ([]*entities.ScoringEvent, error) {
+	if _, err := uc.gameRepo.GetByID(ctx, legID); err != nil {
+		return nil, err
+	}
+	return uc.scoringEventRepo.GetByGameID(ctx, legID)
+}
+
+// UndoThrows removes the last dart, or the whole last visit, of a leg
+func (uc *GameUseCase) UndoThrows(ctx context.Context, legID uuid.UUID, visit bool, recordedBy string, reason *string) (*LegResult, error) {
+	eventType := entities.ScoringEventUndoDart
+	if visit {
+		eventType = entities.ScoringEventUndoVisit
+	}
+	event := entities.NewScoringEvent(legID, eventType, &recordedBy)
+	event.Reason = reason
+	return uc.correctLeg(ctx, event)
+}
+
+// EditVisit replaces one of a leg's visits with new darts or, in visit mode,
+// a new total; later visits are scored again after it
+func (uc *GameUseCase) EditVisit(ctx context.Context, legID uuid.UUID, turn int, darts []entities.Dart, visit *entities.Visit, recordedBy string, reason *string) (*LegResult, error) {
+	event := entities.NewScoringEvent(legID, entities.ScoringEventEditVisit, &recordedBy)
+	event.TurnNumber = turn
+	event.Darts = darts
+	event.Visit = visit
+	event.Reason = reason
+	return uc.correctLeg(ctx, event)
+}
+
+// correctLeg appends a correction to the latest leg of an ongoing match and
+// rebuilds the leg, its throws and the match score from the scoring log
+func (uc *GameUseCase) correctLeg(ctx context.Context, correction *entities.ScoringEvent) (*LegResult, error) {
+	leg, err := uc.gameRepo.GetByID(ctx, correction.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var throws []*entities.Throw
+	match, err := updateMatch(ctx, uc.repoFactory, leg.MatchID, func(uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament) error {
+		var err error
+		leg, err = uow.Games().GetByID(ctx, correction.GameID)
+		if err != nil {
+			return err
+		}
+		if match.Status != entities.MatchStatusInProgress {
+			return entities.ErrMatchNotInProgress
+		}
+		legs, err := uow.Games().GetByMatchID(ctx, match.ID)
+		if err != nil {
+			return err
+		}
+		if legs[len(legs)-1].ID != leg.ID {
+			return entities.ErrLegClosed
+		}
+
+		// Replay the log with the correction before recording it
+		events, err := uow.ScoringEvents().GetByGameID(ctx, leg.ID)
+		if err != nil {
+			return err
+		}
+		correction.Sequence = len(events) + 1
+		throws, err = replayScoringLog(leg, match, tournament, append(events, correction))
+		if err != nil {
+			return err
+		}
+		if err := uow.ScoringEvents().Create(ctx, correction); err != nil {
+			return err
+		}
+
+		// Rebuild the throws and the leg
+		if err := uow.Throws().DeleteByGameID(ctx, leg.ID); err != nil {
+			return err
+		}
+		for _, throw := range throws {
+			if err := uow.Throws().Create(ctx, throw); err != nil {
+				return err
+			}
+		}
+		if err := uow.Games().Update(ctx, leg); err != nil {
+			return err
+		}
+
+		return scoreMatchFromLegs(ctx, uow, match, tournament)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LegResult{Leg: leg, Match: match, Throws: throws}, nil
+}
+
+// appendScoringEvent numbers an event and adds it to the end of its leg's
+// scoring log
+func appendScoringEvent(ctx context.Context, uow repositories.UnitOfWork, event *entities.ScoringEvent) error {
+	events, err := uow.ScoringEvents().GetByGameID(ctx, event.GameID)
+	if err != nil {
+		return err
+	}
+	event.Sequence = len(events) + 1
+	return uow.ScoringEvents().Create(ctx, event)
+}
@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"sort"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// drawGroups spreads the seeded players across the groups in serpentine
+// order, so seeds 1..N head different groups and group strength is balanced
+func drawGroups(players []uuid.UUID, groupCount int) [][]uuid.UUID {
+	groups := make([][]uuid.UUID, groupCount)
+	for i, playerID := range players {
+		pass, index := i/groupCount, i%groupCount
+		if pass%2 == 1 {
+			index = groupCount - 1 - index
+		}
+		groups[index] = append(groups[index], playerID)
+	}
+	return groups
+}
+
+// buildGroupStage draws the players into groups and schedules a round robin
+// inside every group
+func buildGroupStage(tournamentID uuid.UUID, players []uuid.UUID, groupCount int) []*entities.Match {
+	var matches []*entities.Match
+	for i, group := range drawGroups(players, groupCount) {
+		groupNumber := i + 1
+		for _, match := range buildRoundRobinSchedule(tournamentID, group, 1) {
+			match.GroupNumber = &groupNumber
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// groupMembers returns the players of every group, derived from the group
+// stage matches
+func groupMembers(matches []*entities.Match) map[int][]uuid.UUID {
+	members := make(map[int][]uuid.UUID)
+	seen := make(map[uuid.UUID]bool)
+	for _, match := range matches {
+		if match.GroupNumber == nil {
+			continue
+		}
+		for _, playerID := range []*uuid.UUID{match.Player1ID, match.Player2ID} {
+			if playerID != nil && !seen[*playerID] {
+				seen[*playerID] = true
+				members[*match.GroupNumber] = append(members[*match.GroupNumber], *playerID)
+			}
+		}
+	}
+	return members
+}
+
+// groupNumbers returns the group numbers in ascending order
+func groupNumbers(members map[int][]uuid.UUID) []int {
+	numbers := make([]int, 0, len(members))
+	for number := range members {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+	return numbers
+}
+
+// groupStageComplete returns true once every group match has been played
+// and the knockout bracket has not been drawn yet
+func groupStageComplete(matches []*entities.Match) bool {
+	groupMatches := 0
+	for _, match := range matches {
+		if match.BracketSide != entities.BracketSideGroup {
+			return false // knockout already drawn
+		}
+		if match.Status != entities.MatchStatusCompleted {
+			return false
+		}
+		groupMatches++
+	}
+	return groupMatches > 0
+}
+
+// groupQualifiers returns the knockout seeding: group winners first, then
+// runners-up ordered so that each winner opens against a runner-up from
+// another group, then any further qualifiers by group
+func groupQualifiers(matches []*entities.Match, tiebreakers []entities.TableTiebreaker, perGroup int) []uuid.UUID {
+	members := groupMembers(matches)
+	numbers := groupNumbers(members)
+	groupCount := len(numbers)
+
+	finishers := make([][]uuid.UUID, perGroup) // finishers[place][group]
+	for _, number := range numbers {
+		table := buildTable(members[number], groupMatchesOf(matches, number), tiebreakers)
+		for place := 0; place < perGroup && place < len(table); place++ {
+			finishers[place] = append(finishers[place], table[place].PlayerID)
+		}
+	}
+
+	var seeds []uuid.UUID
+	for place, players := range finishers {
+		if place == 1 && groupCount > 1 && len(players) == groupCount {
+			// Runner-up seed G+j meets winner seed G+1-j in a full bracket;
+			// give that seed the runner-up of the next group along
+			runnersUp := make([]uuid.UUID, groupCount)
+			for j := 1; j <= groupCount; j++ {
+				opponentGroup := groupCount + 1 - j
+				runnersUp[j-1] = players[opponentGroup%groupCount]
+			}
+			players = runnersUp
+		}
+		seeds = append(seeds, players...)
+	}
+	return seeds
+}
+
+// groupMatchesOf returns the matches played in one group
+func groupMatchesOf(matches []*entities.Match, groupNumber int) []*entities.Match {
+	var group []*entities.Match
+	for _, match := range matches {
+		if match.GroupNumber != nil && *match.GroupNumber == groupNumber {
+			group = append(group, match)
+		}
+	}
+	return group
+}
@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"testing"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// playGroups plays every group match, the better seed always winning
+func playGroups(t *testing.T, matches []*entities.Match, seeds map[uuid.UUID]int) {
+	t.Helper()
+	for _, match := range matches {
+		winnerID := *match.Player1ID
+		if seeds[*match.Player2ID] < seeds[winnerID] {
+			winnerID = *match.Player2ID
+		}
+		playMatch(t, match, winnerID)
+	}
+}
+
+func TestDrawGroups(t *testing.T) {
+	players := newPlayerIDs(10)
+	seeds := seedRanks(players)
+
+	groups := drawGroups(players, 3)
+	want := [][]int{{1, 6, 7}, {2, 5, 8}, {3, 4, 9, 10}}
+	if len(groups) != len(want) {
+		t.Fatalf("%d groups, want %d", len(groups), len(want))
+	}
+	for i, group := range groups {
+		if len(group) != len(want[i]) {
+			t.Errorf("group %d has %d players, want %d", i+1, len(group), len(want[i]))
+			continue
+		}
+		for j, playerID := range group {
+			if seeds[playerID] != want[i][j] {
+				t.Errorf("group %d place %d: seed %d, want %d", i+1, j+1, seeds[playerID], want[i][j])
+			}
+		}
+	}
+}
+
+func TestBuildGroupStage(t *testing.T) {
+	for _, tt := range []struct{ players, groups int }{{8, 2}, {10, 3}, {9, 4}} {
+		players := newPlayerIDs(tt.players)
+		matches := buildGroupStage(uuid.New(), players, tt.groups)
+
+		groupOf := make(map[uuid.UUID]int)
+		for i, group := range drawGroups(players, tt.groups) {
+			for _, playerID := range group {
+				groupOf[playerID] = i + 1
+			}
+		}
+
+		// Every pair within a group meets exactly once, nobody across groups
+		met := make(map[[2]uuid.UUID]int)
+		for _, match := range matches {
+			if match.IsBye || match.Player1ID == nil || match.Player2ID == nil {
+				t.Fatalf("%d players in %d groups: match without two players", tt.players, tt.groups)
+			}
+			if match.BracketSide != entities.BracketSideGroup || match.GroupNumber == nil {
+				t.Fatalf("%d players in %d groups: match not in a group", tt.players, tt.groups)
+			}
+			p1, p2 := *match.Player1ID, *match.Player2ID
+			if groupOf[p1] != *match.GroupNumber || groupOf[p2] != *match.GroupNumber {
+				t.Errorf("%d players in %d groups: group %d match between groups %d and %d",
+					tt.players, tt.groups, *match.GroupNumber, groupOf[p1], groupOf[p2])
+			}
+			if p2.String() < p1.String() {
+				p1, p2 = p2, p1
+			}
+			met[[2]uuid.UUID{p1, p2}]++
+		}
+
+		for _, count := range met {
+			if count != 1 {
+				t.Errorf("%d players in %d groups: a pair met %d times", tt.players, tt.groups, count)
+			}
+		}
+		pairs := 0
+		for i, p1 := range players {
+			for _, p2 := range players[i+1:] {
+				if groupOf[p1] == groupOf[p2] {
+					pairs++
+				}
+			}
+		}
+		if len(met) != pairs {
+			t.Errorf("%d players in %d groups: %d pairings, want %d", tt.players, tt.groups, len(met), pairs)
+		}
+	}
+}
+
+// Group winners are seeded first, and no first-round knockout match is a
+// rematch between players from the same group
+func TestGroupQualifiers(t *testing.T) {
+	for _, groupCount := range []int{2, 3, 4} {
+		players := newPlayerIDs(3 * groupCount)
+		seeds := seedRanks(players)
+		matches := buildGroupStage(uuid.New(), players, groupCount)
+		playGroups(t, matches, seeds)
+
+		qualifiers := groupQualifiers(matches, entities.DefaultTableTiebreakers, 2)
+		if len(qualifiers) != 2*groupCount {
+			t.Fatalf("%d groups: %d qualifiers, want %d", groupCount, len(qualifiers), 2*groupCount)
+		}
+		for i := 0; i < groupCount; i++ {
+			if seeds[qualifiers[i]] != i+1 {
+				t.Errorf("%d groups: knockout seed %d is seed %d, want the winner of group %d", groupCount, i+1, seeds[qualifiers[i]], i+1)
+			}
+		}
+
+		groupOf := make(map[uuid.UUID]int)
+		for number, members := range groupMembers(matches) {
+			for _, playerID := range members {
+				groupOf[playerID] = number
+			}
+		}
+		order := bracketSeedOrder(bracketSize(len(qualifiers)))
+		for i := 0; i < len(order); i += 2 {
+			seed1, seed2 := order[i], order[i+1]
+			if seed1 > len(qualifiers) || seed2 > len(qualifiers) {
+				continue // bye
+			}
+			if groupOf[qualifiers[seed1-1]] == groupOf[qualifiers[seed2-1]] {
+				t.Errorf("%d groups: knockout seeds %d and %d both come from group %d",
+					groupCount, seed1, seed2, groupOf[qualifiers[seed1-1]])
+			}
+		}
+	}
+}
@@ -7,6 +7,40 @@ import (
 	"darts-league-backend/internal/domain/repositories"
 )
 
+// LeaguePointsSettings holds the points system and standings order supplied
+// when a league is created or edited; nil fields keep the current values
+type LeaguePointsSettings struct {
+	Schedule             entities.PointsSchedule
+	ParticipationPoints  *int
+	PointsPerMatchWin    *int
+	StandingsTiebreakers []entities.StandingsTiebreaker
+}
+
+// apply copies the supplied points system onto the league
+func (s LeaguePointsSettings) apply(league *entities.League) error {
+	if s.StandingsTiebreakers != nil {
+		if err := league.SetStandingsTiebreakers(s.StandingsTiebreakers); err != nil {
+			return err
+		}
+	}
+
+	if s.Schedule == nil && s.ParticipationPoints == nil && s.PointsPerMatchWin == nil {
+		return nil
+	}
+
+	schedule, participation, perMatchWin := league.PointsSchedule, league.ParticipationPoints, league.PointsPerMatchWin
+	if s.Schedule != nil {
+		schedule = s.Schedule
+	}
+	if s.ParticipationPoints != nil {
+		participation = *s.ParticipationPoints
+	}
+	if s.PointsPerMatchWin != nil {
+		perMatchWin = *s.PointsPerMatchWin
+	}
+	return league.SetPointsSystem(schedule, participation, perMatchWin)
+}
+
 type LeagueUseCase struct {
 	leagueRepo   repositories.LeagueRepository
 	standingsRepo repositories.LeagueStandingsRepository
@@ -20,13 +54,19 @@ func NewLeagueUseCase(leagueRepo repositories.LeagueRepository, standingsRepo re
 }
 
 // CreateLeague creates a new league
-func (uc *LeagueUseCase) CreateLeague(ctx context.Context, name, description, season string) (*entities.League, error) {
+func (uc *LeagueUseCase) CreateLeague(ctx context.Context, name, description, season string, points LeaguePointsSettings) (*entities.League, error) {
 	// Create league entity (includes validation)
 	league, err := entities.NewLeague(name, description, season)
 	if err != nil {
 		return nil, err
 	}
 
+	// Apply points system
+	err = points.apply(league)
+	if err != nil {
+		return nil, err
+	}
+
 	// Save to database
 	err = uc.leagueRepo.Create(ctx, league)
 	if err != nil {
@@ -41,6 +81,39 @@ func (uc *LeagueUseCase) GetLeague(ctx context.Context, id uuid.UUID) (*entities
 	return uc.leagueRepo.GetByID(ctx, id)
 }
 
+// UpdateLeaguePoints changes the points system of a league that has not
+// started yet, or the standings tiebreakers of any league
+func (uc *LeagueUseCase) UpdateLeaguePoints(ctx context.Context, id uuid.UUID, points LeaguePointsSettings) (*entities.League, error) {
+	// Get league
+	league, err := uc.leagueRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply points system (includes business rules)
+	err = points.apply(league)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save changes
+	err = uc.leagueRepo.Update(ctx, league)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the standings with the new tiebreakers, keeping the movement
+	// since the last tournament
+	if points.StandingsTiebreakers != nil {
+		err = uc.standingsRepo.RecalculatePositions(ctx, league.ID, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return league, nil
+}
+
 // GetAllLeagues retrieves all leagues
 func (uc *LeagueUseCase) GetAllLeagues(ctx context.Context, limit, offset int) ([]*entities.League, error) {
 	return uc.leagueRepo.GetAll(ctx, limit, offset)
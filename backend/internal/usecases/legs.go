@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// legTally summarises the legs of a match
+type legTally struct {
+	score1, score2 int  // match score: sets when playing sets, otherwise legs
+	legs1, legs2   int  // legs won in the current (or last finished) set
+	set, leg       int  // set and leg number of the next leg
+	inProgress     bool // a leg has been started but not finished
+	lastThrower    *uuid.UUID
+}
+
+// tallyLegs derives the match score from the completed legs, which must be in
+// the order they were played, and any legs awarded by handicap. When the
+// tournament plays sets, legs are counted towards sets and the match score
+// counts sets.
+func tallyLegs(match *entities.Match, legs []*entities.Game, tournament *entities.Tournament) legTally {
+	tally := legTally{set: 1, leg: 1}
+	setOver := false
+
+	// Handicap legs are already won, in the first set when playing sets
+	tally.legs1 = match.Player1Handicap.LegsAwarded
+	tally.legs2 = match.Player2Handicap.LegsAwarded
+	if setLength(tournament, 0, 0).Units == 0 {
+		tally.score1, tally.score2 = tally.legs1, tally.legs2
+	}
+	for _, leg := range legs {
+		thrower := leg.FirstThrowerID
+		tally.lastThrower = &thrower
+		if !leg.IsCompleted() {
+			tally.inProgress = true
+			continue
+		}
+
+		wonByPlayer1 := match.Player1ID != nil && *leg.WinnerID == *match.Player1ID
+		tally.leg++
+		setLength := setLength(tournament, tally.score1, tally.score2)
+		if setLength.Units == 0 {
+			if wonByPlayer1 {
+				tally.score1++
+			} else {
+				tally.score2++
+			}
+			tally.legs1, tally.legs2 = tally.score1, tally.score2
+			continue
+		}
+
+		// A finished set's legs stand until the next set gets under way
+		if setOver {
+			tally.legs1, tally.legs2 = 0, 0
+			setOver = false
+		}
+		if wonByPlayer1 {
+			tally.legs1++
+		} else {
+			tally.legs2++
+		}
+		if setLength.IsComplete(tally.legs1, tally.legs2) {
+			if tally.legs1 > tally.legs2 {
+				tally.score1++
+			} else {
+				tally.score2++
+			}
+			setOver = true
+			tally.set++
+			tally.leg = 1
+		}
+	}
+	return tally
+}
+
+// scoreMatchFromLegs sets the match score from its completed legs, which
+// completes the match once the score decides it
+func scoreMatchFromLegs(ctx context.Context, uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament) error {
+	legs, err := uow.Games().GetByMatchID(ctx, match.ID)
+	if err != nil {
+		return err
+	}
+	tally := tallyLegs(match, legs, tournament)
+	if err := match.UpdateScore(tally.score1, tally.score2, matchLength(tournament)); err != nil {
+		return err
+	}
+	match.UpdateSetLegs(tally.legs1, tally.legs2)
+	return nil
+}
+
+// setLength returns the length of the set played at the given set score in
+// the tournament's matches; standalone matches are not played in sets
+func setLength(tournament *entities.Tournament, sets1, sets2 int) entities.MatchLength {
+	if tournament == nil {
+		return entities.MatchLength{}
+	}
+	return tournament.SetLength(sets1, sets2)
+}
+
+// legGameType returns the game played in a match's legs: the tournament's
+// game, or for standalone matches the match's own, 501 by default
+func legGameType(match *entities.Match, tournament *entities.Tournament) entities.GameType {
+	switch {
+	case tournament != nil:
+		return tournament.GameType
+	case match.GameType != "":
+		return match.GameType
+	}
+	return entities.GameType501
+}
+
+// legX01Rules returns the rules a match's X01 legs are played under, with
+// the players' handicap start scores; standalone matches start on their
+// game's usual score, straight in and double out
+func legX01Rules(match *entities.Match, tournament *entities.Tournament) entities.X01Rules {
+	if tournament != nil {
+		return tournament.X01Rules().WithHandicaps(match)
+	}
+	rules := entities.DefaultX01Rules
+	if startScore := legGameType(match, tournament).StartScore(); startScore > 0 {
+		rules.StartScore = startScore
+	}
+	return rules.WithHandicaps(match)
+}
+
+// legRules returns the rules of the game played in a match's legs
+func legRules(match *entities.Match, tournament *entities.Tournament) (entities.GameRules, error) {
+	return entities.NewGameRules(legGameType(match, tournament), legX01Rules(match, tournament))
+}
+
+// throwDart scores the next dart of a leg under the rules of its game
+func throwDart(leg *entities.Game, match *entities.Match, throws []*entities.Throw, dart entities.Dart, tournament *entities.Tournament) (*entities.Throw, error) {
+	rules, err := legRules(match, tournament)
+	if err != nil {
+		return nil, err
+	}
+	return leg.Throw(rules, match, throws, dart)
+}
+
+// scoreVisit scores the next visit of a leg from its total; only X01 legs
+// can be scored by visit
+func scoreVisit(leg *entities.Game, match *entities.Match, throws []*entities.Throw, visit entities.Visit, tournament *entities.Tournament) (*entities.Throw, error) {
+	if !legGameType(match, tournament).IsX01() {
+		return nil, entities.ErrGameNotScored
+	}
+	return leg.ScoreVisit(match, throws, visit, legX01Rules(match, tournament))
+}
@@ -2,20 +2,30 @@ package usecases
 
 import (
 	"context"
+	"math"
 	"github.com/google/uuid"
 	"darts-league-backend/internal/domain/entities"
 	"darts-league-backend/internal/domain/repositories"
 )
 
 type MatchUseCase struct {
-	matchRepo     repositories.MatchRepository
-	standingsRepo repositories.LeagueStandingsRepository
+	matchRepo      repositories.MatchRepository
+	tournamentRepo repositories.TournamentRepository
+	standingsRepo  repositories.LeagueStandingsRepository
+	repoFactory    repositories.RepositoryFactory
 }
 
-func NewMatchUseCase(matchRepo repositories.MatchRepository, standingsRepo repositories.LeagueStandingsRepository) *MatchUseCase {
+func NewMatchUseCase(
+	matchRepo repositories.MatchRepository,
+	tournamentRepo repositories.TournamentRepository,
+	standingsRepo repositories.LeagueStandingsRepository,
+	repoFactory repositories.RepositoryFactory,
+) *MatchUseCase {
 	return &MatchUseCase{
-		matchRepo:     matchRepo,
-		standingsRepo: standingsRepo,
+		matchRepo:      matchRepo,
+		tournamentRepo: tournamentRepo,
+		standingsRepo:  standingsRepo,
+		repoFactory:    repoFactory,
 	}
 }
 
@@ -34,7 +44,9 @@ func (uc *MatchUseCase) GetTournamentMatches(ctx context.Context, tournamentID u
 	return uc.matchRepo.GetByTournamentID(ctx, tournamentID)
 }
 
-// StartMatch begins a match
+// StartMatch begins a match. A standalone match is started with the given
+// players; a tournament match must be ready and is started with the players
+// its tournament put into it, which the given players have to match.
 func (uc *MatchUseCase) StartMatch(ctx context.Context, matchID uuid.UUID, player1ID, player2ID uuid.UUID) (*entities.Match, error) {
 	// Get match
 	match, err := uc.matchRepo.GetByID(ctx, matchID)
@@ -43,9 +55,18 @@ func (uc *MatchUseCase) StartMatch(ctx context.Context, matchID uuid.UUID, playe
 	}
 
 	// Set players
-	err = match.SetPlayers(player1ID, player2ID)
-	if err != nil {
-		return nil, err
+	if match.TournamentID == uuid.Nil {
+		err = match.SetPlayers(player1ID, player2ID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if match.Status == entities.MatchStatusPending {
+			return nil, entities.ErrMatchMissingPlayers
+		}
+		if !match.HasPlayers(player1ID, player2ID) {
+			return nil, entities.ErrPlayerNotInMatch
+		}
 	}
 
 	// Start match
@@ -63,22 +84,176 @@ func (uc *MatchUseCase) StartMatch(ctx context.Context, matchID uuid.UUID, playe
 	return match, nil
 }
 
-// UpdateMatchScore updates the score of an ongoing match
+// UpdateMatchScore updates the score of an ongoing match. The score must fit
+// the tournament's match length; once it decides the match, the match is
+// completed and its tournament progresses as for CompleteMatch. Matches
+// played leg by leg take their score from the legs instead.
 func (uc *MatchUseCase) UpdateMatchScore(ctx context.Context, matchID uuid.UUID, player1Score, player2Score int) (*entities.Match, error) {
-	// Get match
-	match, err := uc.matchRepo.GetByID(ctx, matchID)
-	if err != nil {
-		return nil, err
+	return updateMatch(ctx, uc.repoFactory, matchID, func(uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament) error {
+		legs, err := uow.Games().GetByMatchID(ctx, match.ID)
+		if err != nil {
+			return err
+		}
+		if len(legs) > 0 {
+			return entities.ErrScoreFromLegs
+		}
+		return match.UpdateScore(player1Score, player2Score, matchLength(tournament))
+	})
+}
+
+// CompleteMatch finishes a match, advances its players through the bracket
+// and, after the final, completes the tournament and awards league points,
+// all in one transaction
+func (uc *MatchUseCase) CompleteMatch(ctx context.Context, matchID uuid.UUID, winnerID uuid.UUID) (*entities.Match, error) {
+	return updateMatch(ctx, uc.repoFactory, matchID, func(_ repositories.UnitOfWork, match *entities.Match, _ *entities.Tournament) error {
+		return match.CompleteMatch(winnerID)
+	})
+}
+
+// AwardMatch completes a match by walkover, forfeit or retirement. The winner
+// advances exactly as if the match had been played.
+func (uc *MatchUseCase) AwardMatch(ctx context.Context, matchID uuid.UUID, winnerID uuid.UUID, outcome entities.MatchOutcome, reason string) (*entities.Match, error) {
+	return updateMatch(ctx, uc.repoFactory, matchID, func(_ repositories.UnitOfWork, match *entities.Match, _ *entities.Tournament) error {
+		return match.Award(winnerID, outcome, reason)
+	})
+}
+
+// RecordThrowOff records the bull-off or coin toss won by the player who
+// throws first in the match's first leg. It can be recorded, or corrected,
+// until the first leg starts.
+func (uc *MatchUseCase) RecordThrowOff(ctx context.Context, matchID uuid.UUID, method entities.ThrowOffMethod, winnerID uuid.UUID) (*entities.Match, error) {
+	return updateMatch(ctx, uc.repoFactory, matchID, func(uow repositories.UnitOfWork, match *entities.Match, _ *entities.Tournament) error {
+		legs, err := uow.Games().GetByMatchID(ctx, match.ID)
+		if err != nil {
+			return err
+		}
+		if len(legs) > 0 {
+			return entities.ErrThrowOffTooLate
+		}
+		return match.RecordThrowOff(method, winnerID)
+	})
+}
+
+// SetHandicaps sets both players' handicaps by hand. Handicaps can be set,
+// or corrected, until the first leg starts.
+func (uc *MatchUseCase) SetHandicaps(ctx context.Context, matchID uuid.UUID, handicap1, handicap2 entities.Handicap) (*entities.Match, error) {
+	return updateMatch(ctx, uc.repoFactory, matchID, func(uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament) error {
+		return setHandicaps(ctx, uow, match, tournament, handicap1, handicap2)
+	})
+}
+
+// ComputeHandicaps sets both players' handicaps from the handicap table and
+// their averages in the tournament's league; the default table is used when
+// none is given. Players without a league average yet are given no head
+// start.
+func (uc *MatchUseCase) ComputeHandicaps(ctx context.Context, matchID uuid.UUID, method entities.HandicapMethod, table entities.HandicapTable) (*entities.Match, error) {
+	if !method.IsValid() {
+		return nil, entities.ErrInvalidHandicap
+	}
+	if len(table) == 0 {
+		table = entities.DefaultHandicapTable
 	}
 
-	// Update score
-	err = match.UpdateScore(player1Score, player2Score)
+	return updateMatch(ctx, uc.repoFactory, matchID, func(uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament) error {
+		if tournament == nil || tournament.LeagueID == uuid.Nil {
+			return entities.ErrHandicapNoLeague
+		}
+		if match.Player1ID == nil || match.Player2ID == nil {
+			return entities.ErrMatchMissingPlayers
+		}
+
+		averages, err := uow.Standings().GetPlayerAverages(ctx, tournament.LeagueID)
+		if err != nil {
+			return err
+		}
+		average := func(playerID uuid.UUID) float64 {
+			if average, ok := averages[playerID]; ok {
+				return average
+			}
+			return math.Inf(1)
+		}
+
+		startScore := tournament.X01Rules().StartScore
+		handicap1, handicap2 := table.Handicaps(average(*match.Player1ID), average(*match.Player2ID), method, startScore)
+		return setHandicaps(ctx, uow, match, tournament, handicap1, handicap2)
+	})
+}
+
+// setHandicaps checks that a match can still take handicaps and sets them
+func setHandicaps(ctx context.Context, uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament, handicap1, handicap2 entities.Handicap) error {
+	legs, err := uow.Games().GetByMatchID(ctx, match.ID)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if len(legs) > 0 {
+		return entities.ErrHandicapTooLate
 	}
+	if (handicap1.StartScore > 0 || handicap2.StartScore > 0) && !legGameType(match, tournament).IsX01() {
+		return entities.ErrHandicapNotX01
+	}
+	return match.SetHandicaps(handicap1, handicap2, matchLength(tournament), setLength(tournament, 0, 0))
+}
 
-	// Save changes
-	err = uc.matchRepo.Update(ctx, match)
+// updateMatch applies a change to a match in one transaction and, when the
+// change completes the match, progresses its tournament. The change receives
+// the match's tournament, which is nil for standalone matches.
+func updateMatch(
+	ctx context.Context,
+	repoFactory repositories.RepositoryFactory,
+	matchID uuid.UUID,
+	update func(uow repositories.UnitOfWork, match *entities.Match, tournament *entities.Tournament) error,
+) (*entities.Match, error) {
+	var match *entities.Match
+	err := runInTransaction(ctx, repoFactory, func(uow repositories.UnitOfWork) error {
+		// Get match
+		var err error
+		match, err = uow.Matches().GetByID(ctx, matchID)
+		if err != nil {
+			return err
+		}
+
+		var tournament *entities.Tournament
+		if match.TournamentID != uuid.Nil {
+			tournament, err = uow.Tournaments().GetByID(ctx, match.TournamentID)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Apply the change
+		before := *match
+		err = update(uow, match, tournament)
+		if err != nil {
+			return err
+		}
+
+		// Save changes
+		err = uow.Matches().Update(ctx, match)
+		if err != nil {
+			return err
+		}
+
+		// Legs won or undone, or a result, change the players' statistics
+		if tournament != nil && resultChanged(&before, match) {
+			if err := recalculateMatchStats(ctx, uow.Statistics(), match, tournament); err != nil {
+				return err
+			}
+		}
+
+		if match.Status != entities.MatchStatusCompleted {
+			return nil
+		}
+
+		// Route players into the next matches
+		progression := &tournamentProgression{
+			tournamentRepo: uow.Tournaments(),
+			matchRepo:      uow.Matches(),
+			leagueRepo:     uow.Leagues(),
+			standingsRepo:  uow.Standings(),
+			statsRepo:      uow.Statistics(),
+		}
+		return progression.matchCompleted(ctx, match)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -86,30 +261,47 @@ func (uc *MatchUseCase) UpdateMatchScore(ctx context.Context, matchID uuid.UUID,
 	return match, nil
 }
 
-// CompleteMatch finishes a match and updates standings
-func (uc *MatchUseCase) CompleteMatch(ctx context.Context, matchID uuid.UUID, winnerID uuid.UUID) (*entities.Match, error) {
-	// Get match
-	match, err := uc.matchRepo.GetByID(ctx, matchID)
-	if err != nil {
-		return nil, err
-	}
+// resultChanged returns true if a change to a match altered its score, the
+// legs of the current set or its result
+func resultChanged(before, after *entities.Match) bool {
+	return before.Player1Score != after.Player1Score || before.Player2Score != after.Player2Score ||
+		before.Player1Legs != after.Player1Legs || before.Player2Legs != after.Player2Legs ||
+		before.Status != after.Status || before.Outcome != after.Outcome
+}
 
-	// Complete match
-	err = match.CompleteMatch(winnerID)
-	if err != nil {
-		return nil, err
+// recalculateMatchStats recalculates both players' statistics in the match's
+// tournament and its league
+func recalculateMatchStats(ctx context.Context, statsRepo repositories.StatisticsRepository, match *entities.Match, tournament *entities.Tournament) error {
+	for _, playerID := range []*uuid.UUID{match.Player1ID, match.Player2ID} {
+		if playerID == nil {
+			continue
+		}
+		if err := recalculatePlayerStats(ctx, statsRepo, tournament, *playerID); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	// Save changes
-	err = uc.matchRepo.Update(ctx, match)
-	if err != nil {
-		return nil, err
+// recalculatePlayerStats recalculates a player's statistics in a tournament
+// and then in its league, which are added up from the tournaments
+func recalculatePlayerStats(ctx context.Context, statsRepo repositories.StatisticsRepository, tournament *entities.Tournament, playerID uuid.UUID) error {
+	if err := statsRepo.RecalculateTournamentStats(ctx, tournament.ID, playerID); err != nil {
+		return err
 	}
+	if tournament.LeagueID == uuid.Nil {
+		return nil
+	}
+	return statsRepo.RecalculateLeagueStats(ctx, tournament.LeagueID, playerID)
+}
 
-	// TODO: Update tournament progression and league standings
-	// This would be more complex in a real implementation
-
-	return match, nil
+// matchLength returns the length of a tournament's matches; standalone
+// matches have no limit
+func matchLength(tournament *entities.Tournament) entities.MatchLength {
+	if tournament == nil {
+		return entities.MatchLength{}
+	}
+	return tournament.MatchLength()
 }
 
 // GetPlayerMatches retrieves matches for a specific player
@@ -55,8 +55,8 @@ func (uc *PlayerUseCase) GetAllPlayers(ctx context.Context, limit, offset int) (
 	return uc.playerRepo.GetAll(ctx, limit, offset)
 }
 
-// UpdatePlayer updates player information
-func (uc *PlayerUseCase) UpdatePlayer(ctx context.Context, id uuid.UUID, name string, email, nickname, avatarURL *string) (*entities.Player, error) {
+// UpdatePlayer updates player information; a nil rating keeps the current one
+func (uc *PlayerUseCase) UpdatePlayer(ctx context.Context, id uuid.UUID, name string, email, nickname, avatarURL *string, rating *int) (*entities.Player, error) {
 	// Get existing player
 	player, err := uc.playerRepo.GetByID(ctx, id)
 	if err != nil {
@@ -69,6 +69,13 @@ func (uc *PlayerUseCase) UpdatePlayer(ctx context.Context, id uuid.UUID, name st
 		return nil, err
 	}
 
+	if rating != nil {
+		err = player.SetRating(*rating)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Save changes
 	err = uc.playerRepo.Update(ctx, player)
 	if err != nil {
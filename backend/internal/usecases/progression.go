@@ -0,0 +1,180 @@
+package usecases
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// tournamentProgression moves players through a tournament as its matches
+// are completed
+type tournamentProgression struct {
+	tournamentRepo repositories.TournamentRepository
+	matchRepo      repositories.MatchRepository
+	leagueRepo     repositories.LeagueRepository
+	standingsRepo  repositories.LeagueStandingsRepository
+	statsRepo      repositories.StatisticsRepository
+}
+
+// matchCompleted routes the players of a completed match into the matches
+// that depend on it, creates any matches the result unlocks and completes the
+// tournament once nothing is left to play
+func (p *tournamentProgression) matchCompleted(ctx context.Context, match *entities.Match) error {
+	if match.TournamentID == uuid.Nil {
+		return nil // standalone match
+	}
+
+	tournament, err := p.tournamentRepo.GetByID(ctx, match.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	matches, err := p.matchRepo.GetByTournamentID(ctx, match.TournamentID)
+	if err != nil {
+		return err
+	}
+
+	b := newBracket(matches)
+	b.replace(match)
+	if err := b.advance(match); err != nil {
+		return err
+	}
+
+	for _, changed := range b.changed {
+		if err := p.matchRepo.Update(ctx, changed); err != nil {
+			return err
+		}
+	}
+
+	var created []*entities.Match
+	switch tournament.Type {
+	case entities.TournamentTypeDoubleElimination:
+		created, err = p.checkBracketReset(ctx, tournament, match)
+	case entities.TournamentTypeGroupKnockout:
+		created, err = p.checkGroupStage(ctx, tournament, b.ordered)
+	}
+	if err != nil {
+		return err
+	}
+
+	return p.checkTournamentComplete(ctx, tournament, append(b.ordered, created...))
+}
+
+// checkGroupStage draws the knockout bracket from the group qualifiers once
+// the last group match has been completed
+func (p *tournamentProgression) checkGroupStage(ctx context.Context, tournament *entities.Tournament, matches []*entities.Match) ([]*entities.Match, error) {
+	if !groupStageComplete(matches) {
+		return nil, nil
+	}
+
+	qualifiers := groupQualifiers(matches, tournament.Tiebreakers, tournament.QualifiersPerGroup)
+	knockout, err := buildSingleEliminationBracket(tournament.ID, qualifiers)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.matchRepo.CreateBracketMatches(ctx, knockout); err != nil {
+		return nil, err
+	}
+	return knockout, nil
+}
+
+// checkBracketReset creates the deciding grand final when the losers-bracket
+// player wins the first one
+func (p *tournamentProgression) checkBracketReset(ctx context.Context, tournament *entities.Tournament, match *entities.Match) ([]*entities.Match, error) {
+	if !tournament.GrandFinalReset || match.BracketSide != entities.BracketSideGrandFinal || match.Round != 1 {
+		return nil, nil
+	}
+	if match.WinnerID == nil || match.Player2ID == nil || *match.WinnerID != *match.Player2ID {
+		return nil, nil // winners-bracket player won the title
+	}
+
+	reset := entities.NewMatch(tournament.ID, 2, 1)
+	reset.BracketSide = entities.BracketSideGrandFinal
+	if err := reset.SetPlayers(*match.Player1ID, *match.Player2ID); err != nil {
+		return nil, err
+	}
+	if err := p.matchRepo.Create(ctx, reset); err != nil {
+		return nil, err
+	}
+	return []*entities.Match{reset}, nil
+}
+
+// checkTournamentComplete completes the tournament once its final has been
+// played: the last bracket match, the last round-robin match or the last
+// match of the final Swiss round
+func (p *tournamentProgression) checkTournamentComplete(ctx context.Context, tournament *entities.Tournament, matches []*entities.Match) error {
+	if !tournament.IsInProgress() || !tournamentFinished(tournament, matches) {
+		return nil
+	}
+
+	if err := tournament.CompleteTournament(); err != nil {
+		return err
+	}
+	if err := p.tournamentRepo.Update(ctx, tournament); err != nil {
+		return err
+	}
+	return p.awardLeaguePoints(ctx, tournament, matches)
+}
+
+// awardLeaguePoints records every entrant's final position and league points
+// and adds the result to the league standings and statistics
+func (p *tournamentProgression) awardLeaguePoints(ctx context.Context, tournament *entities.Tournament, matches []*entities.Match) error {
+	league, err := p.leagueRepo.GetByID(ctx, tournament.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	players, err := p.tournamentRepo.GetPlayers(ctx, tournament.ID)
+	if err != nil {
+		return err
+	}
+
+	positions := finalPositions(tournament, seededPlayerIDs(players), matches)
+	rounds := eliminationRounds(tournament, positions, matches)
+	wins := matchWins(matches)
+	for _, player := range players {
+		position, ok := positions[player.PlayerID]
+		if !ok {
+			continue
+		}
+		points := league.TournamentPoints(position, rounds[player.PlayerID], wins[player.PlayerID])
+
+		if err := p.tournamentRepo.SetPlayerPosition(ctx, tournament.ID, player.PlayerID, position, points); err != nil {
+			return err
+		}
+		if err := p.standingsRepo.AddPoints(ctx, league.ID, player.PlayerID, points); err != nil {
+			return err
+		}
+		if err := p.standingsRepo.UpdateTournamentStats(ctx, league.ID, player.PlayerID, rounds[player.PlayerID]); err != nil {
+			return err
+		}
+
+		// Final positions count towards tournament wins and podiums
+		if err := recalculatePlayerStats(ctx, p.statsRepo, tournament, player.PlayerID); err != nil {
+			return err
+		}
+	}
+
+	return p.standingsRepo.RecalculatePositions(ctx, league.ID, true)
+}
+
+// tournamentFinished returns true when every match has been played and the
+// format has no further matches to create
+func tournamentFinished(tournament *entities.Tournament, matches []*entities.Match) bool {
+	if len(matches) == 0 {
+		return false
+	}
+	for _, match := range matches {
+		if match.Status != entities.MatchStatusCompleted {
+			return false
+		}
+	}
+
+	if tournament.Type == entities.TournamentTypeSwiss {
+		return currentRound(matches) >= tournament.SwissRounds
+	}
+	return true
+}
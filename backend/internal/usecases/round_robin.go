@@ -0,0 +1,247 @@
+package usecases
+
+import (
+	"sort"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// Table points awarded per match result
+const (
+	tablePointsForWin  = 2
+	tablePointsForDraw = 1
+)
+
+// tableKeyPoints orders the table before any configured tiebreaker applies
+const tableKeyPoints entities.TableTiebreaker = "points"
+
+// TableRow is one player's line in a round-robin table
+type TableRow struct {
+	Group         *int      `json:"group,omitempty"`
+	Position      int       `json:"position"`
+	PlayerID      uuid.UUID `json:"player_id"`
+	Played        int       `json:"played"`
+	Won           int       `json:"won"`
+	Drawn         int       `json:"drawn"`
+	Lost          int       `json:"lost"`
+	LegsFor       int       `json:"legs_for"`
+	LegsAgainst   int       `json:"legs_against"`
+	LegDifference int       `json:"leg_difference"`
+	Points        int       `json:"points"`
+
+	// Opponent-strength tiebreaks, used by Swiss tournaments
+	Buchholz        float64 `json:"buchholz"`
+	SonnebornBerger float64 `json:"sonneborn_berger"`
+}
+
+// buildRoundRobinSchedule pairs every player with every other player using
+// the circle method. Odd fields get a bye each round, which produces no match.
+func buildRoundRobinSchedule(tournamentID uuid.UUID, players []uuid.UUID, firstRound int) []*entities.Match {
+	slots := make([]*uuid.UUID, len(players))
+	for i := range players {
+		slots[i] = &players[i]
+	}
+	if len(slots)%2 == 1 {
+		slots = append(slots, nil) // bye
+	}
+
+	var matches []*entities.Match
+	n := len(slots)
+	for round := 0; round < n-1; round++ {
+		matchNumber := 1
+		for i := 0; i < n/2; i++ {
+			home, away := slots[i], slots[n-1-i]
+			if home == nil || away == nil {
+				continue
+			}
+			// Alternate the fixed player's slot so nobody is always player 1
+			if i == 0 && round%2 == 1 {
+				home, away = away, home
+			}
+
+			match := entities.NewMatch(tournamentID, firstRound+round, matchNumber)
+			match.BracketSide = entities.BracketSideGroup
+			match.Player1ID = home
+			match.Player2ID = away
+			match.Status = entities.MatchStatusReady
+			matches = append(matches, match)
+			matchNumber++
+		}
+
+		// Rotate every slot but the first one position clockwise
+		last := slots[n-1]
+		copy(slots[2:], slots[1:n-1])
+		slots[1] = last
+	}
+	return matches
+}
+
+// buildTable computes the round-robin table for the given players from the
+// completed matches and orders it by points and the configured tiebreakers.
+// Byes and awarded matches count as results without legs.
+func buildTable(players []uuid.UUID, matches []*entities.Match, tiebreakers []entities.TableTiebreaker) []*TableRow {
+	rows := make([]*TableRow, len(players))
+	byPlayer := make(map[uuid.UUID]*TableRow, len(players))
+	for i, playerID := range players {
+		rows[i] = &TableRow{PlayerID: playerID}
+		byPlayer[playerID] = rows[i]
+	}
+
+	for _, match := range matches {
+		if match.IsBye && match.WinnerID != nil {
+			if row, ok := byPlayer[*match.WinnerID]; ok {
+				row.addBye()
+			}
+			continue
+		}
+		if !match.IsDecided() {
+			continue
+		}
+		row1, ok1 := byPlayer[*match.Player1ID]
+		row2, ok2 := byPlayer[*match.Player2ID]
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		// Awarded matches count as results, but their legs are not counted
+		legs1, legs2 := match.Player1Score, match.Player2Score
+		if match.IsAwarded() {
+			legs1, legs2 = 0, 0
+		}
+		row1.addResult(legs1, legs2, match.WinnerID)
+		row2.addResult(legs2, legs1, match.WinnerID)
+	}
+	addOpponentScores(byPlayer, matches)
+
+	keys := append([]entities.TableTiebreaker{tableKeyPoints}, tiebreakers...)
+	orderTableRows(rows, keys, matches)
+	for i, row := range rows {
+		row.Position = i + 1
+	}
+	return rows
+}
+
+// addResult records one match from this row's player's point of view
+func (r *TableRow) addResult(legsFor, legsAgainst int, winnerID *uuid.UUID) {
+	r.Played++
+	switch {
+	case winnerID == nil:
+		r.Drawn++
+		r.Points += tablePointsForDraw
+	case *winnerID == r.PlayerID:
+		r.Won++
+		r.Points += tablePointsForWin
+	default:
+		r.Lost++
+	}
+	r.LegsFor += legsFor
+	r.LegsAgainst += legsAgainst
+	r.LegDifference = r.LegsFor - r.LegsAgainst
+}
+
+// addBye records a bye as a win
+func (r *TableRow) addBye() {
+	r.Played++
+	r.Won++
+	r.Points += tablePointsForWin
+}
+
+// addOpponentScores computes each row's Buchholz score (the sum of its
+// opponents' points) and Sonneborn-Berger score (the points of beaten
+// opponents plus half the points of drawn ones)
+func addOpponentScores(byPlayer map[uuid.UUID]*TableRow, matches []*entities.Match) {
+	for _, match := range matches {
+		if !match.IsDecided() {
+			continue
+		}
+		row1, ok1 := byPlayer[*match.Player1ID]
+		row2, ok2 := byPlayer[*match.Player2ID]
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		row1.Buchholz += float64(row2.Points)
+		row2.Buchholz += float64(row1.Points)
+		switch {
+		case match.WinnerID == nil:
+			row1.SonnebornBerger += float64(row2.Points) / 2
+			row2.SonnebornBerger += float64(row1.Points) / 2
+		case *match.WinnerID == row1.PlayerID:
+			row1.SonnebornBerger += float64(row2.Points)
+		default:
+			row2.SonnebornBerger += float64(row1.Points)
+		}
+	}
+}
+
+// orderTableRows sorts rows by the first key, then splits each group that is
+// still level using the remaining keys
+func orderTableRows(rows []*TableRow, keys []entities.TableTiebreaker, matches []*entities.Match) {
+	if len(rows) < 2 || len(keys) == 0 {
+		return
+	}
+
+	values := tableKeyValues(rows, keys[0], matches)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return values[rows[i].PlayerID] > values[rows[j].PlayerID]
+	})
+
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && values[rows[end].PlayerID] == values[rows[start].PlayerID] {
+			end++
+		}
+		orderTableRows(rows[start:end], keys[1:], matches)
+		start = end
+	}
+}
+
+// tableKeyValues returns each row's value for a sort key; higher is better
+func tableKeyValues(rows []*TableRow, key entities.TableTiebreaker, matches []*entities.Match) map[uuid.UUID]float64 {
+	values := make(map[uuid.UUID]float64, len(rows))
+	switch key {
+	case entities.TiebreakerHeadToHead:
+		// Points earned only in matches between the tied players
+		tied := make(map[uuid.UUID]bool, len(rows))
+		for _, row := range rows {
+			tied[row.PlayerID] = true
+		}
+		for _, match := range matches {
+			if !match.IsDecided() || !tied[*match.Player1ID] || !tied[*match.Player2ID] {
+				continue
+			}
+			if match.WinnerID == nil {
+				values[*match.Player1ID] += tablePointsForDraw
+				values[*match.Player2ID] += tablePointsForDraw
+				continue
+			}
+			values[*match.WinnerID] += tablePointsForWin
+		}
+	default:
+		for _, row := range rows {
+			values[row.PlayerID] = row.keyValue(key)
+		}
+	}
+	return values
+}
+
+// keyValue returns the row's own value for a sort key
+func (r *TableRow) keyValue(key entities.TableTiebreaker) float64 {
+	switch key {
+	case tableKeyPoints:
+		return float64(r.Points)
+	case entities.TiebreakerLegDifference:
+		return float64(r.LegDifference)
+	case entities.TiebreakerLegsWon:
+		return float64(r.LegsFor)
+	case entities.TiebreakerMatchesWon:
+		return float64(r.Won)
+	case entities.TiebreakerBuchholz:
+		return r.Buchholz
+	case entities.TiebreakerSonnebornBerger:
+		return r.SonnebornBerger
+	}
+	return 0
+}
@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// legEntry is one scored entry of a leg: a single dart or, in visit mode, a
+// visit total. Each entry becomes one throw.
+type legEntry struct {
+	dart  entities.Dart
+	visit *entities.Visit
+}
+
+// eventEntries returns the entries a darts, visit or edit event scores
+func eventEntries(event *entities.ScoringEvent) []legEntry {
+	if event.Visit != nil {
+		return []legEntry{{visit: event.Visit}}
+	}
+	entries := make([]legEntry, len(event.Darts))
+	for i, dart := range event.Darts {
+		entries[i] = legEntry{dart: dart}
+	}
+	return entries
+}
+
+// replayScoringLog rebuilds a leg from its scoring log and returns the throws
+// it is made of. The events reduce to the darts and visit totals that still
+// stand, in the order they were entered, and any winner entered without
+// darts; the leg is then scored again from its first dart, so the same log
+// always gives the same scores, busts and winner.
+func replayScoringLog(leg *entities.Game, match *entities.Match, tournament *entities.Tournament, events []*entities.ScoringEvent) ([]*entities.Throw, error) {
+	var entries []legEntry
+	var winnerID *uuid.UUID
+	for _, event := range events {
+		switch event.Type {
+		case entities.ScoringEventDarts, entities.ScoringEventVisit:
+			entries = append(entries, eventEntries(event)...)
+
+		case entities.ScoringEventCompleteLeg:
+			winnerID = event.WinnerID
+
+		case entities.ScoringEventUndoDart, entities.ScoringEventUndoVisit:
+			// Undoing a result entered without darts reopens the leg
+			if winnerID != nil {
+				winnerID = nil
+				continue
+			}
+			if len(entries) == 0 {
+				return nil, entities.ErrNothingToUndo
+			}
+			if event.Type == entities.ScoringEventUndoDart {
+				entries = entries[:len(entries)-1]
+				continue
+			}
+
+			throws, err := replayEntries(leg, match, tournament, entries, nil)
+			if err != nil {
+				return nil, err
+			}
+			first, _ := visitDarts(throws, throws[len(throws)-1].TurnNumber)
+			entries = entries[:first]
+
+		case entities.ScoringEventEditVisit:
+			throws, err := replayEntries(leg, match, tournament, entries, nil)
+			if err != nil {
+				return nil, err
+			}
+			first, count := visitDarts(throws, event.TurnNumber)
+			if count == 0 {
+				return nil, entities.ErrVisitNotFound
+			}
+			edited := append([]legEntry{}, entries[:first]...)
+			edited = append(edited, eventEntries(event)...)
+			entries = append(edited, entries[first+count:]...)
+		}
+	}
+
+	return replayEntries(leg, match, tournament, entries, winnerID)
+}
+
+// replayEntries resets a leg and scores its entries on it again, completing
+// it with the given winner if the entries did not finish it
+func replayEntries(leg *entities.Game, match *entities.Match, tournament *entities.Tournament, entries []legEntry, winnerID *uuid.UUID) ([]*entities.Throw, error) {
+	rules, err := legRules(match, tournament)
+	if err != nil {
+		return nil, err
+	}
+	leg.Reset(rules)
+
+	var throws []*entities.Throw
+	for _, entry := range entries {
+		throw, err := scoreEntry(leg, match, throws, entry, tournament)
+		if err == entities.ErrLegNotInProgress || err == entities.ErrVisitInProgress {
+			return nil, entities.ErrInvalidCorrection
+		}
+		if err != nil {
+			return nil, err
+		}
+		throws = append(throws, throw)
+	}
+
+	if winnerID != nil {
+		if err := leg.Complete(*winnerID); err != nil {
+			return nil, entities.ErrInvalidCorrection
+		}
+	}
+	return throws, nil
+}
+
+// scoreEntry scores a dart or a visit total on the leg
+func scoreEntry(leg *entities.Game, match *entities.Match, throws []*entities.Throw, entry legEntry, tournament *entities.Tournament) (*entities.Throw, error) {
+	if entry.visit != nil {
+		return scoreVisit(leg, match, throws, *entry.visit, tournament)
+	}
+	return throwDart(leg, match, throws, entry.dart, tournament)
+}
+
+// visitDarts returns the position of a visit's first throw among the throws
+// and how many throws it had
+func visitDarts(throws []*entities.Throw, turn int) (first, count int) {
+	for i, throw := range throws {
+		if throw.TurnNumber != turn {
+			continue
+		}
+		if count == 0 {
+			first = i
+		}
+		count++
+	}
+	return first, count
+}
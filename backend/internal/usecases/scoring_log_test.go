@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"testing"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+func treble(segment int) entities.Dart { return entities.Dart{Segment: segment, Multiplier: 3} }
+func single(segment int) entities.Dart { return entities.Dart{Segment: segment, Multiplier: 1} }
+
+func dartsEvent(darts ...entities.Dart) *entities.ScoringEvent {
+	return &entities.ScoringEvent{Type: entities.ScoringEventDarts, Darts: darts}
+}
+
+func visitEvent(total int) *entities.ScoringEvent {
+	return &entities.ScoringEvent{Type: entities.ScoringEventVisit, Visit: &entities.Visit{Total: total}}
+}
+
+func editEvent(turn int, event *entities.ScoringEvent) *entities.ScoringEvent {
+	event.Type = entities.ScoringEventEditVisit
+	event.TurnNumber = turn
+	return event
+}
+
+func TestReplayScoringLog(t *testing.T) {
+	player1, player2 := uuid.New(), uuid.New()
+	undoDart := &entities.ScoringEvent{Type: entities.ScoringEventUndoDart}
+	undoVisit := &entities.ScoringEvent{Type: entities.ScoringEventUndoVisit}
+	complete := func(winnerID uuid.UUID) *entities.ScoringEvent {
+		return &entities.ScoringEvent{Type: entities.ScoringEventCompleteLeg, WinnerID: &winnerID}
+	}
+
+	tests := []struct {
+		name           string
+		events         []*entities.ScoringEvent
+		score1, score2 int
+		throws         int
+		winnerID       *uuid.UUID
+		err            error
+	}{
+		{
+			name:   "darts and visit totals",
+			events: []*entities.ScoringEvent{dartsEvent(treble(20), treble(20), treble(20)), visitEvent(100)},
+			score1: 321, score2: 401, throws: 4,
+		},
+		{
+			name:   "undo dart removes the last dart",
+			events: []*entities.ScoringEvent{dartsEvent(treble(20), treble(20), treble(20)), undoDart},
+			score1: 381, score2: 501, throws: 2,
+		},
+		{
+			name:   "undo visit removes a visit scored dart by dart",
+			events: []*entities.ScoringEvent{dartsEvent(treble(20), treble(20)), undoVisit},
+			score1: 501, score2: 501, throws: 0,
+		},
+		{
+			name:   "undo visit removes a visit total",
+			events: []*entities.ScoringEvent{visitEvent(60), visitEvent(45), undoVisit},
+			score1: 441, score2: 501, throws: 1,
+		},
+		{
+			name: "editing an earlier visit rescores the leg",
+			events: []*entities.ScoringEvent{
+				dartsEvent(treble(20), treble(20), treble(20)), visitEvent(60),
+				editEvent(1, dartsEvent(single(20), single(20), single(20))),
+			},
+			score1: 441, score2: 441, throws: 4,
+		},
+		{
+			name: "a visit leaving 1 is a bust",
+			events: []*entities.ScoringEvent{
+				visitEvent(180), visitEvent(0), visitEvent(180), visitEvent(0), visitEvent(140),
+			},
+			score1: 141, score2: 501, throws: 5,
+		},
+		{
+			name: "a checkout wins the leg",
+			events: []*entities.ScoringEvent{
+				visitEvent(180), visitEvent(0), visitEvent(180), visitEvent(0), visitEvent(141),
+			},
+			score1: 0, score2: 501, throws: 5, winnerID: &player1,
+		},
+		{
+			name:   "a winner entered without darts",
+			events: []*entities.ScoringEvent{visitEvent(60), complete(player2)},
+			score1: 441, score2: 501, throws: 1, winnerID: &player2,
+		},
+		{
+			name:   "undo reopens a leg completed without darts",
+			events: []*entities.ScoringEvent{visitEvent(60), complete(player2), undoVisit},
+			score1: 441, score2: 501, throws: 1,
+		},
+		{
+			name:   "nothing to undo",
+			events: []*entities.ScoringEvent{undoDart},
+			err:    entities.ErrNothingToUndo,
+		},
+		{
+			name:   "editing a visit that was never thrown",
+			events: []*entities.ScoringEvent{visitEvent(60), editEvent(3, visitEvent(45))},
+			err:    entities.ErrVisitNotFound,
+		},
+		{
+			name: "an edit that wins the leg before later visits",
+			events: []*entities.ScoringEvent{
+				visitEvent(180), visitEvent(0), visitEvent(180), visitEvent(0), visitEvent(100),
+				visitEvent(0), visitEvent(41), editEvent(5, visitEvent(141)),
+			},
+			err: entities.ErrInvalidCorrection,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tournament, err := entities.NewTournament(uuid.New(), "Replay", entities.TournamentTypeSingleElimination, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			match := entities.NewMatch(tournament.ID, 1, 1)
+			if err := match.SetPlayers(player1, player2); err != nil {
+				t.Fatal(err)
+			}
+			if err := match.StartMatch(); err != nil {
+				t.Fatal(err)
+			}
+			rules, err := legRules(match, tournament)
+			if err != nil {
+				t.Fatal(err)
+			}
+			leg := entities.NewGame(match.ID, 1, 1, player1, rules)
+
+			throws, err := replayScoringLog(leg, match, tournament, tt.events)
+			if err != tt.err {
+				t.Fatalf("replay: got error %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			if leg.Player1Score != tt.score1 || leg.Player2Score != tt.score2 {
+				t.Errorf("scores %d/%d, want %d/%d", leg.Player1Score, leg.Player2Score, tt.score1, tt.score2)
+			}
+			if len(throws) != tt.throws {
+				t.Errorf("%d throws, want %d", len(throws), tt.throws)
+			}
+			switch {
+			case tt.winnerID == nil && leg.WinnerID != nil:
+				t.Errorf("leg won by %v, want it still in progress", *leg.WinnerID)
+			case tt.winnerID != nil && (leg.WinnerID == nil || *leg.WinnerID != *tt.winnerID):
+				t.Errorf("leg winner %v, want %v", leg.WinnerID, *tt.winnerID)
+			}
+
+			// The same log always replays to the same leg
+			again, err := replayScoringLog(leg, match, tournament, tt.events)
+			if err != nil || len(again) != len(throws) || leg.Player1Score != tt.score1 || leg.Player2Score != tt.score2 {
+				t.Errorf("replaying again gave %d throws at %d/%d (%v)", len(again), leg.Player1Score, leg.Player2Score, err)
+			}
+		})
+	}
+}
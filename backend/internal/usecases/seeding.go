@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"time"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// SeedingOptions selects how the entrants are seeded when a tournament
+// starts. The bracket keeps the top seeds apart until the late rounds.
+type SeedingOptions struct {
+	Method      entities.SeedingMethod
+	ManualSeeds map[uuid.UUID]int // manual seeding; unseeded players follow in registration order
+	RandomSeed  *int64            // random draws; generated when nil
+}
+
+// tournamentSeeder orders a tournament's entrants before the draw
+type tournamentSeeder struct {
+	standingsRepo repositories.LeagueStandingsRepository
+	playerRepo    repositories.PlayerRepository
+}
+
+// seed returns the entrants in seed order and records the method (and any
+// random seed) on the tournament. Players start in registration order, with
+// seeds given at registration first.
+func (s *tournamentSeeder) seed(ctx context.Context, tournament *entities.Tournament, players []*repositories.TournamentPlayer, options SeedingOptions) ([]*repositories.TournamentPlayer, error) {
+	method := options.Method
+	if method == "" {
+		method = entities.SeedingRegistration
+	}
+	if !method.IsValid() {
+		return nil, entities.ErrInvalidSeedingMethod
+	}
+
+	seeded := append([]*repositories.TournamentPlayer{}, players...)
+	switch method {
+	case entities.SeedingLeaguePosition:
+		standings, err := s.standingsRepo.GetLeagueStandings(ctx, tournament.LeagueID)
+		if err != nil {
+			return nil, err
+		}
+		positions := make(map[uuid.UUID]int, len(standings))
+		for _, standing := range standings {
+			if standing.CurrentPosition > 0 {
+				positions[standing.PlayerID] = standing.CurrentPosition
+			}
+		}
+		sortSeeds(seeded, func(playerID uuid.UUID) (float64, bool) {
+			position, ok := positions[playerID]
+			return float64(position), ok
+		})
+
+	case entities.SeedingRating:
+		entrants, err := s.playerRepo.GetByIDs(ctx, seededPlayerIDs(players))
+		if err != nil {
+			return nil, err
+		}
+		ratings := make(map[uuid.UUID]int, len(entrants))
+		for _, entrant := range entrants {
+			if entrant.Rating != nil {
+				ratings[entrant.ID] = *entrant.Rating
+			}
+		}
+		sortSeeds(seeded, func(playerID uuid.UUID) (float64, bool) {
+			rating, ok := ratings[playerID]
+			return -float64(rating), ok // highest rating first
+		})
+
+	case entities.SeedingManual:
+		if err := validateManualSeeds(players, options.ManualSeeds); err != nil {
+			return nil, err
+		}
+		sortSeeds(seeded, func(playerID uuid.UUID) (float64, bool) {
+			seed, ok := options.ManualSeeds[playerID]
+			return float64(seed), ok
+		})
+
+	case entities.SeedingRandom:
+		randomSeed := time.Now().UnixNano()
+		if options.RandomSeed != nil {
+			randomSeed = *options.RandomSeed
+		}
+		rng := rand.New(rand.NewSource(randomSeed))
+		rng.Shuffle(len(seeded), func(i, j int) {
+			seeded[i], seeded[j] = seeded[j], seeded[i]
+		})
+		tournament.SeedingRandomSeed = &randomSeed
+	}
+
+	tournament.SeedingMethod = method
+	return seeded, nil
+}
+
+// sortSeeds orders players by ascending key; players without a key keep
+// their relative order after everyone who has one
+func sortSeeds(players []*repositories.TournamentPlayer, key func(playerID uuid.UUID) (float64, bool)) {
+	sort.SliceStable(players, func(i, j int) bool {
+		ki, iok := key(players[i].PlayerID)
+		kj, jok := key(players[j].PlayerID)
+		if iok != jok {
+			return iok
+		}
+		return iok && ki < kj
+	})
+}
+
+// validateManualSeeds checks that manual seeds name tournament players and
+// are unique numbers between 1 and the field size
+func validateManualSeeds(players []*repositories.TournamentPlayer, seeds map[uuid.UUID]int) error {
+	entrants := make(map[uuid.UUID]bool, len(players))
+	for _, player := range players {
+		entrants[player.PlayerID] = true
+	}
+
+	used := make(map[int]bool, len(seeds))
+	for playerID, seed := range seeds {
+		if !entrants[playerID] || seed < 1 || seed > len(players) || used[seed] {
+			return entities.ErrInvalidSeeds
+		}
+		used[seed] = true
+	}
+	return nil
+}
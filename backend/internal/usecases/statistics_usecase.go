@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/entities"
+	"darts-league-backend/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// DefaultLeaderboardSize is the number of players a leaderboard shows when
+// no limit is given
+const DefaultLeaderboardSize = 10
+
+// Leaderboard ranks a league's players by one metric. The benchmarks are the
+// league's average player's value for each metric, where there is one.
+type Leaderboard struct {
+	Metric     entities.LeaderboardMetric             `json:"metric"`
+	Players    []*repositories.LeagueStats            `json:"players"`
+	Benchmarks map[entities.LeaderboardMetric]float64 `json:"benchmarks"`
+}
+
+// HeadToHead is the record between two players: every match they have been
+// drawn against each other in, most recent first, their legs and throwing in
+// those matches, and, within a league, their league statistics side by side
+type HeadToHead struct {
+	Player1       *entities.Player              `json:"player1"`
+	Player2       *entities.Player              `json:"player2"`
+	Matches       []*entities.Match             `json:"matches"`
+	Player1Stats  *repositories.HeadToHeadStats `json:"player1_stats"`
+	Player2Stats  *repositories.HeadToHeadStats `json:"player2_stats"`
+	Player1League *repositories.LeagueStats     `json:"player1_league_stats,omitempty"`
+	Player2League *repositories.LeagueStats     `json:"player2_league_stats,omitempty"`
+}
+
+type StatisticsUseCase struct {
+	statsRepo      repositories.StatisticsRepository
+	leagueRepo     repositories.LeagueRepository
+	tournamentRepo repositories.TournamentRepository
+	playerRepo     repositories.PlayerRepository
+	matchRepo      repositories.MatchRepository
+}
+
+func NewStatisticsUseCase(
+	statsRepo repositories.StatisticsRepository,
+	leagueRepo repositories.LeagueRepository,
+	tournamentRepo repositories.TournamentRepository,
+	playerRepo repositories.PlayerRepository,
+	matchRepo repositories.MatchRepository,
+) *StatisticsUseCase {
+	return &StatisticsUseCase{
+		statsRepo:      statsRepo,
+		leagueRepo:     leagueRepo,
+		tournamentRepo: tournamentRepo,
+		playerRepo:     playerRepo,
+		matchRepo:      matchRepo,
+	}
+}
+
+// GetTournamentStats retrieves every player's statistics in a tournament.
+// They are kept up to date as legs are completed.
+func (uc *StatisticsUseCase) GetTournamentStats(ctx context.Context, tournamentID uuid.UUID) ([]*repositories.TournamentStats, error) {
+	if _, err := uc.tournamentRepo.GetByID(ctx, tournamentID); err != nil {
+		return nil, err
+	}
+	return uc.statsRepo.GetAllTournamentStats(ctx, tournamentID)
+}
+
+// GetPlayerStats retrieves a player's statistics in each of their
+// tournaments, most recent first
+func (uc *StatisticsUseCase) GetPlayerStats(ctx context.Context, playerID uuid.UUID, limit, offset int) ([]*repositories.TournamentStats, error) {
+	if _, err := uc.playerRepo.GetByID(ctx, playerID); err != nil {
+		return nil, err
+	}
+	return uc.statsRepo.GetPlayerTournamentStats(ctx, playerID, limit, offset)
+}
+
+// GetLeaderboard ranks a league's players by a metric, best first, with the
+// league-average benchmarks
+func (uc *StatisticsUseCase) GetLeaderboard(ctx context.Context, leagueID uuid.UUID, metric entities.LeaderboardMetric, limit int) (*Leaderboard, error) {
+	if !metric.IsValid() {
+		return nil, entities.ErrInvalidMetric
+	}
+	if limit <= 0 {
+		limit = DefaultLeaderboardSize
+	}
+	if _, err := uc.leagueRepo.GetByID(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	players, err := uc.statsRepo.GetTopPerformers(ctx, leagueID, metric, limit)
+	if err != nil {
+		return nil, err
+	}
+	leaderboard := &Leaderboard{
+		Metric:     metric,
+		Players:    players,
+		Benchmarks: make(map[entities.LeaderboardMetric]float64),
+	}
+
+	averages, err := uc.statsRepo.GetLeagueAverages(ctx, leagueID)
+	if err != nil && err != entities.ErrStatsNotFound {
+		return nil, err
+	}
+	if averages != nil {
+		for _, benchmarked := range entities.LeaderboardMetrics {
+			if benchmark, ok := averages.MetricValue(benchmarked); ok {
+				leaderboard.Benchmarks[benchmarked] = benchmark
+			}
+		}
+	}
+	return leaderboard, nil
+}
+
+// GetHeadToHead compares two players over the matches they have played
+// against each other. Given a league, only the league's matches count and the
+// players' league statistics are included.
+func (uc *StatisticsUseCase) GetHeadToHead(ctx context.Context, playerID, opponentID uuid.UUID, leagueID *uuid.UUID) (*HeadToHead, error) {
+	if playerID == opponentID {
+		return nil, entities.ErrSamePlayer
+	}
+	player, err := uc.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	opponent, err := uc.playerRepo.GetByID(ctx, opponentID)
+	if err != nil {
+		return nil, err
+	}
+	if leagueID != nil {
+		if _, err := uc.leagueRepo.GetByID(ctx, *leagueID); err != nil {
+			return nil, err
+		}
+	}
+
+	matches, err := uc.matchRepo.GetHeadToHeadMatches(ctx, playerID, opponentID, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	headToHead := &HeadToHead{Player1: player, Player2: opponent, Matches: matches}
+
+	headToHead.Player1Stats, headToHead.Player2Stats, err = uc.statsRepo.GetHeadToHeadStats(ctx, matches, playerID, opponentID)
+	if err != nil {
+		return nil, err
+	}
+	if leagueID != nil {
+		headToHead.Player1League, headToHead.Player2League, err = uc.statsRepo.ComparePlayerStats(ctx, playerID, opponentID, *leagueID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return headToHead, nil
+}
@@ -0,0 +1,189 @@
+package usecases
+
+import (
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// swissPairing is the outcome of pairing one Swiss round
+type swissPairing struct {
+	pairs [][2]uuid.UUID
+	bye   *uuid.UUID
+}
+
+// currentRound returns the highest round that has matches
+func currentRound(matches []*entities.Match) int {
+	round := 0
+	for _, match := range matches {
+		if match.Round > round {
+			round = match.Round
+		}
+	}
+	return round
+}
+
+// pairSwissRound pairs players who are level on points, working down the
+// ranking. Rematches are avoided when a bounded search finds a rematch-free
+// pairing, and the bye goes to the lowest-ranked player with the fewest byes
+// so far.
+func pairSwissRound(ranking []*TableRow, matches []*entities.Match) swissPairing {
+	played := make(map[[2]uuid.UUID]bool)
+	byes := make(map[uuid.UUID]int)
+	for _, match := range matches {
+		if match.IsBye && match.WinnerID != nil {
+			byes[*match.WinnerID]++
+			continue
+		}
+		if match.Player1ID != nil && match.Player2ID != nil {
+			played[pairKey(*match.Player1ID, *match.Player2ID)] = true
+		}
+	}
+
+	var result swissPairing
+	remaining := ranking
+	if len(remaining)%2 == 1 {
+		byeIndex := len(remaining) - 1
+		for i := len(remaining) - 1; i >= 0; i-- {
+			if byes[remaining[i].PlayerID] < byes[remaining[byeIndex].PlayerID] {
+				byeIndex = i
+			}
+		}
+		result.bye = &remaining[byeIndex].PlayerID
+		remaining = append(append([]*TableRow{}, remaining[:byeIndex]...), remaining[byeIndex+1:]...)
+	}
+
+	attempts := swissPairingAttempts
+	pairs, ok := pairSwissPlayers(remaining, played, &attempts)
+	if !ok {
+		// No rematch-free pairing was found; keep rematches to a minimum
+		// without searching any further
+		pairs = pairSwissGreedy(remaining, played)
+	}
+	result.pairs = pairs
+	return result
+}
+
+// swissPairingAttempts caps the partial pairings tried while searching for a
+// rematch-free round. When no such round exists the search would otherwise
+// grow exponentially with the field.
+const swissPairingAttempts = 10000
+
+// pairSwissPlayers pairs the top remaining player with the most suitable
+// opponent and recurses, backtracking when the rest cannot be paired, until
+// it runs out of attempts
+func pairSwissPlayers(remaining []*TableRow, played map[[2]uuid.UUID]bool, attempts *int) ([][2]uuid.UUID, bool) {
+	if len(remaining) == 0 {
+		return nil, true
+	}
+	if *attempts <= 0 {
+		return nil, false
+	}
+	*attempts--
+
+	first := remaining[0]
+	for _, index := range swissCandidates(remaining) {
+		opponent := remaining[index]
+		if played[pairKey(first.PlayerID, opponent.PlayerID)] {
+			continue
+		}
+
+		if pairs, ok := pairSwissPlayers(withoutPair(remaining, index), played, attempts); ok {
+			return append([][2]uuid.UUID{{first.PlayerID, opponent.PlayerID}}, pairs...), true
+		}
+	}
+	return nil, false
+}
+
+// pairSwissGreedy pairs each top remaining player with the most suitable
+// opponent they have not met, or the most suitable opponent if they have met
+// everyone left, without backtracking
+func pairSwissGreedy(remaining []*TableRow, played map[[2]uuid.UUID]bool) [][2]uuid.UUID {
+	var pairs [][2]uuid.UUID
+	for len(remaining) > 0 {
+		first := remaining[0]
+		candidates := swissCandidates(remaining)
+		index := candidates[0]
+		for _, candidate := range candidates {
+			if !played[pairKey(first.PlayerID, remaining[candidate].PlayerID)] {
+				index = candidate
+				break
+			}
+		}
+
+		pairs = append(pairs, [2]uuid.UUID{first.PlayerID, remaining[index].PlayerID})
+		remaining = withoutPair(remaining, index)
+	}
+	return pairs
+}
+
+// withoutPair returns the remaining players other than the first and the
+// opponent at index
+func withoutPair(remaining []*TableRow, index int) []*TableRow {
+	rest := make([]*TableRow, 0, len(remaining)-2)
+	for i, row := range remaining[1:] {
+		if i+1 != index {
+			rest = append(rest, row)
+		}
+	}
+	return rest
+}
+
+// swissCandidates returns opponent indexes for the first player in order of
+// preference: within the score group the player half a group down comes
+// first (top half meets bottom half), then lower score groups in order
+func swissCandidates(remaining []*TableRow) []int {
+	groupSize := 1
+	for groupSize < len(remaining) && remaining[groupSize].Points == remaining[0].Points {
+		groupSize++
+	}
+
+	var candidates []int
+	if groupSize > 1 {
+		half := groupSize / 2
+		for i := half; i < groupSize; i++ {
+			candidates = append(candidates, i)
+		}
+		for i := half - 1; i >= 1; i-- {
+			candidates = append(candidates, i)
+		}
+	}
+	for i := groupSize; i < len(remaining); i++ {
+		candidates = append(candidates, i)
+	}
+	return candidates
+}
+
+// buildSwissRound creates the matches for one Swiss round
+func buildSwissRound(tournamentID uuid.UUID, round int, pairing swissPairing) ([]*entities.Match, error) {
+	var matches []*entities.Match
+	for i, pair := range pairing.pairs {
+		match := entities.NewMatch(tournamentID, round, i+1)
+		match.BracketSide = entities.BracketSideGroup
+		if err := match.SetPlayers(pair[0], pair[1]); err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	if pairing.bye != nil {
+		match := entities.NewMatch(tournamentID, round, len(matches)+1)
+		match.BracketSide = entities.BracketSideGroup
+		if err := match.AssignSlot(1, *pairing.bye); err != nil {
+			return nil, err
+		}
+		if err := match.CompleteWithBye(*pairing.bye); err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
+
+// pairKey returns an order-independent key for two players
+func pairKey(a, b uuid.UUID) [2]uuid.UUID {
+	if a.String() > b.String() {
+		a, b = b, a
+	}
+	return [2]uuid.UUID{a, b}
+}
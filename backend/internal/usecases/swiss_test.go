@@ -0,0 +1,159 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"darts-league-backend/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// Plays several Swiss rounds, the better seed always winning, and checks
+// every round: each player appears exactly once, nobody meets the same
+// opponent twice, and the bye goes to the lowest-ranked player who has not
+// had one yet
+func TestPairSwissRound(t *testing.T) {
+	for _, tt := range []struct{ players, rounds int }{{4, 3}, {5, 3}, {7, 4}, {8, 3}, {9, 4}} {
+		players := newPlayerIDs(tt.players)
+		seeds := seedRanks(players)
+		tournamentID := uuid.New()
+		byes := make(map[uuid.UUID]int)
+		met := make(map[[2]uuid.UUID]int)
+		var matches []*entities.Match
+
+		for round := 1; round <= tt.rounds; round++ {
+			ranking := buildTable(players, matches, entities.DefaultSwissTiebreakers)
+			pairing := pairSwissRound(ranking, matches)
+
+			seen := make(map[uuid.UUID]int)
+			for _, pair := range pairing.pairs {
+				seen[pair[0]]++
+				seen[pair[1]]++
+				met[pairKey(pair[0], pair[1])]++
+				if met[pairKey(pair[0], pair[1])] > 1 {
+					t.Errorf("%d players round %d: seeds %d and %d meet again", tt.players, round, seeds[pair[0]], seeds[pair[1]])
+				}
+			}
+
+			if tt.players%2 == 0 {
+				if pairing.bye != nil {
+					t.Errorf("%d players round %d: unexpected bye", tt.players, round)
+				}
+			} else if pairing.bye == nil {
+				t.Fatalf("%d players round %d: no bye", tt.players, round)
+			} else {
+				seen[*pairing.bye]++
+				for i := len(ranking) - 1; ranking[i].PlayerID != *pairing.bye; i-- {
+					if byes[ranking[i].PlayerID] == 0 {
+						t.Errorf("%d players round %d: bye to seed %d over lower-ranked seed %d",
+							tt.players, round, seeds[*pairing.bye], seeds[ranking[i].PlayerID])
+						break
+					}
+				}
+				byes[*pairing.bye]++
+				if byes[*pairing.bye] > 1 {
+					t.Errorf("%d players round %d: seed %d gets a second bye", tt.players, round, seeds[*pairing.bye])
+				}
+			}
+
+			for _, playerID := range players {
+				if seen[playerID] != 1 {
+					t.Errorf("%d players round %d: seed %d appears %d times", tt.players, round, seeds[playerID], seen[playerID])
+				}
+			}
+
+			roundMatches, err := buildSwissRound(tournamentID, round, pairing)
+			if err != nil {
+				t.Fatalf("%d players round %d: %v", tt.players, round, err)
+			}
+			for _, match := range roundMatches {
+				if match.IsBye {
+					continue
+				}
+				winnerID := *match.Player1ID
+				if seeds[*match.Player2ID] < seeds[winnerID] {
+					winnerID = *match.Player2ID
+				}
+				playMatch(t, match, winnerID)
+			}
+			matches = append(matches, roundMatches...)
+		}
+	}
+}
+
+// Once every opponent has been met the round is still paired, on points
+func TestPairSwissRoundFallsBackToRematches(t *testing.T) {
+	players := newPlayerIDs(2)
+	matches, err := buildSwissRound(uuid.New(), 1, swissPairing{pairs: [][2]uuid.UUID{{players[0], players[1]}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	playMatch(t, matches[0], players[0])
+
+	ranking := buildTable(players, matches, entities.DefaultSwissTiebreakers)
+	pairing := pairSwissRound(ranking, matches)
+	if len(pairing.pairs) != 1 || pairing.pairs[0] != [2]uuid.UUID{players[0], players[1]} {
+		t.Errorf("pairs %v, want the rematch", pairing.pairs)
+	}
+}
+
+// Two odd groups of players who have each met everyone in the other group
+// cannot be paired without a rematch; the search gives up in good time and
+// pairs the round with the single rematch it needs
+func TestPairSwissRoundWithoutRematchFreePairing(t *testing.T) {
+	players := newPlayerIDs(22)
+	seeds := seedRanks(players)
+	inGroupA := make(map[uuid.UUID]bool)
+	var groupA, groupB []uuid.UUID
+	for i, playerID := range players {
+		if i%2 == 0 {
+			inGroupA[playerID] = true
+			groupA = append(groupA, playerID)
+		} else {
+			groupB = append(groupB, playerID)
+		}
+	}
+
+	var matches []*entities.Match
+	for _, a := range groupA {
+		for _, b := range groupB {
+			match := entities.NewMatch(uuid.New(), len(matches)+1, 1)
+			if err := match.SetPlayers(a, b); err != nil {
+				t.Fatal(err)
+			}
+			winnerID := a
+			if seeds[b] < seeds[a] {
+				winnerID = b
+			}
+			playMatch(t, match, winnerID)
+			matches = append(matches, match)
+		}
+	}
+
+	ranking := buildTable(players, matches, entities.DefaultSwissTiebreakers)
+	done := make(chan swissPairing, 1)
+	go func() { done <- pairSwissRound(ranking, matches) }()
+	var pairing swissPairing
+	select {
+	case pairing = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pairing a field without a rematch-free pairing did not finish")
+	}
+
+	seen := make(map[uuid.UUID]int)
+	rematches := 0
+	for _, pair := range pairing.pairs {
+		seen[pair[0]]++
+		seen[pair[1]]++
+		if inGroupA[pair[0]] != inGroupA[pair[1]] {
+			rematches++
+		}
+	}
+	if len(seen) != len(players) || len(pairing.pairs) != len(players)/2 {
+		t.Errorf("%d pairs covering %d players, want every player once", len(pairing.pairs), len(seen))
+	}
+	if rematches != 1 {
+		t.Errorf("%d rematches, want 1", rematches)
+	}
+}
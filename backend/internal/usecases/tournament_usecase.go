@@ -8,26 +8,123 @@ import (
 	"github.com/google/uuid"
 )
 
+// TournamentSettings holds the optional format settings supplied when a
+// tournament is created; nil fields keep the defaults
+type TournamentSettings struct {
+	GrandFinalReset    *bool
+	Tiebreakers        []entities.TableTiebreaker
+	GroupCount         *int
+	QualifiersPerGroup *int
+	SwissRounds        *int
+
+	// Match length; unset values keep the tournament's current ones
+	MatchFormat      entities.MatchFormat
+	LegsPerMatch     *int
+	SetsPerMatch     *int
+	FinalSetTiebreak *bool
+
+	// Game played in the legs; empty keeps the current one
+	GameType entities.GameType
+
+	// X01 starting score and in/out rules; unset values keep the current ones
+	StartScore *int
+	InRule     entities.CheckRule
+	OutRule    entities.CheckRule
+}
+
+// apply copies the supplied settings onto the tournament
+func (s TournamentSettings) apply(tournament *entities.Tournament) error {
+	if s.GrandFinalReset != nil {
+		tournament.GrandFinalReset = *s.GrandFinalReset
+	}
+	if s.Tiebreakers != nil {
+		if err := tournament.SetTiebreakers(s.Tiebreakers); err != nil {
+			return err
+		}
+	}
+	if s.GroupCount != nil || s.QualifiersPerGroup != nil {
+		groupCount, qualifiers := tournament.GroupCount, tournament.QualifiersPerGroup
+		if s.GroupCount != nil {
+			groupCount = *s.GroupCount
+		}
+		if s.QualifiersPerGroup != nil {
+			qualifiers = *s.QualifiersPerGroup
+		}
+		if err := tournament.SetGroupSettings(groupCount, qualifiers); err != nil {
+			return err
+		}
+	}
+	if s.SwissRounds != nil {
+		if err := tournament.SetSwissRounds(*s.SwissRounds); err != nil {
+			return err
+		}
+	}
+	if s.MatchFormat != "" || s.LegsPerMatch != nil || s.SetsPerMatch != nil {
+		format, legs, sets := tournament.MatchFormat, tournament.LegsPerMatch, tournament.SetsPerMatch
+		if s.MatchFormat != "" {
+			format = s.MatchFormat
+		}
+		if s.LegsPerMatch != nil {
+			legs = *s.LegsPerMatch
+		}
+		if s.SetsPerMatch != nil {
+			sets = *s.SetsPerMatch
+		}
+		if err := tournament.SetMatchFormat(format, legs, sets); err != nil {
+			return err
+		}
+	}
+	if s.FinalSetTiebreak != nil {
+		if err := tournament.SetFinalSetTiebreak(*s.FinalSetTiebreak); err != nil {
+			return err
+		}
+	}
+	if s.GameType != "" {
+		if err := tournament.SetGameType(s.GameType); err != nil {
+			return err
+		}
+	}
+	if s.StartScore != nil || s.InRule != "" || s.OutRule != "" {
+		rules := tournament.X01Rules()
+		if s.StartScore != nil {
+			rules.StartScore = *s.StartScore
+		}
+		if s.InRule != "" {
+			rules.InRule = s.InRule
+		}
+		if s.OutRule != "" {
+			rules.OutRule = s.OutRule
+		}
+		if err := tournament.SetX01Rules(rules.StartScore, rules.InRule, rules.OutRule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type TournamentUseCase struct {
 	tournamentRepo repositories.TournamentRepository
 	leagueRepo     repositories.LeagueRepository
 	matchRepo      repositories.MatchRepository
+	repoFactory    repositories.RepositoryFactory
 }
 
 func NewTournamentUseCase(
 	tournamentRepo repositories.TournamentRepository,
 	leagueRepo repositories.LeagueRepository,
 	matchRepo repositories.MatchRepository,
+	repoFactory repositories.RepositoryFactory,
 ) *TournamentUseCase {
 	return &TournamentUseCase{
 		tournamentRepo: tournamentRepo,
 		leagueRepo:     leagueRepo,
 		matchRepo:      matchRepo,
+		repoFactory:    repoFactory,
 	}
 }
 
 // CreateTournament creates a new tournament in a league
-func (uc *TournamentUseCase) CreateTournament(ctx context.Context, leagueID uuid.UUID, name string, tournamentType entities.TournamentType) (*entities.Tournament, error) {
+func (uc *TournamentUseCase) CreateTournament(ctx context.Context, leagueID uuid.UUID, name string, tournamentType entities.TournamentType, settings TournamentSettings) (*entities.Tournament, error) {
 	// Check if league exists and can add tournaments
 	league, err := uc.leagueRepo.GetByID(ctx, leagueID)
 	if err != nil {
@@ -50,6 +147,12 @@ func (uc *TournamentUseCase) CreateTournament(ctx context.Context, leagueID uuid
 		return nil, err
 	}
 
+	// Apply format settings
+	err = settings.apply(tournament)
+	if err != nil {
+		return nil, err
+	}
+
 	// Save to database
 	err = uc.tournamentRepo.Create(ctx, tournament)
 	if err != nil {
@@ -59,6 +162,37 @@ func (uc *TournamentUseCase) CreateTournament(ctx context.Context, leagueID uuid
 	return tournament, nil
 }
 
+// UpdateTournament renames a tournament and changes its settings before it
+// starts
+func (uc *TournamentUseCase) UpdateTournament(ctx context.Context, id uuid.UUID, name *string, settings TournamentSettings) (*entities.Tournament, error) {
+	tournament, err := uc.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if tournament.Status != entities.TournamentStatusSetup {
+		return nil, entities.ErrTournamentAlreadyStarted
+	}
+
+	if name != nil {
+		if err := tournament.Rename(*name); err != nil {
+			return nil, err
+		}
+	}
+
+	err = settings.apply(tournament)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.tournamentRepo.Update(ctx, tournament)
+	if err != nil {
+		return nil, err
+	}
+
+	return tournament, nil
+}
+
 // GetTournament retrieves a tournament by ID
 func (uc *TournamentUseCase) GetTournament(ctx context.Context, id uuid.UUID) (*entities.Tournament, error) {
 	return uc.tournamentRepo.GetByID(ctx, id)
@@ -69,8 +203,8 @@ func (uc *TournamentUseCase) GetLeagueTournaments(ctx context.Context, leagueID
 	return uc.tournamentRepo.GetByLeagueID(ctx, leagueID)
 }
 
-// AddPlayerToTournament adds a player to a tournament
-func (uc *TournamentUseCase) AddPlayerToTournament(ctx context.Context, tournamentID, playerID uuid.UUID) error {
+// AddPlayerToTournament adds a player to a tournament, optionally with a seed
+func (uc *TournamentUseCase) AddPlayerToTournament(ctx context.Context, tournamentID, playerID uuid.UUID, seed *int) error {
 	// Get tournament
 	tournament, err := uc.tournamentRepo.GetByID(ctx, tournamentID)
 	if err != nil {
@@ -92,52 +226,187 @@ func (uc *TournamentUseCase) AddPlayerToTournament(ctx context.Context, tourname
 	}
 
 	// Add player
-	return uc.tournamentRepo.AddPlayer(ctx, tournamentID, playerID, nil)
+	return uc.tournamentRepo.AddPlayer(ctx, tournamentID, playerID, seed)
 }
 
-// StartTournament begins a tournament and generates bracket
-func (uc *TournamentUseCase) StartTournament(ctx context.Context, id uuid.UUID) (*entities.Tournament, error) {
-	// Get tournament
-	tournament, err := uc.tournamentRepo.GetByID(ctx, id)
+// StartTournament seeds the entrants, begins the tournament and generates
+// the bracket in one transaction
+func (uc *TournamentUseCase) StartTournament(ctx context.Context, id uuid.UUID, seeding SeedingOptions) (*entities.Tournament, error) {
+	var tournament *entities.Tournament
+	err := runInTransaction(ctx, uc.repoFactory, func(uow repositories.UnitOfWork) error {
+		// Get tournament
+		var err error
+		tournament, err = uow.Tournaments().GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// Get registered players
+		players, err := uow.Tournaments().GetPlayers(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(players) < 2 {
+			return entities.ErrNotEnoughPlayers
+		}
+
+		// Start tournament (includes business rules)
+		err = tournament.StartTournament()
+		if err != nil {
+			return err
+		}
+
+		// Seed the field
+		seeder := &tournamentSeeder{standingsRepo: uow.Standings(), playerRepo: uow.Players()}
+		players, err = seeder.seed(ctx, tournament, players, seeding)
+		if err != nil {
+			return err
+		}
+
+		// Generate bracket
+		matches, err := generateMatches(tournament, seededPlayerIDs(players))
+		if err != nil {
+			return err
+		}
+
+		// Save tournament changes
+		err = uow.Tournaments().Update(ctx, tournament)
+		if err != nil {
+			return err
+		}
+
+		// Save seeds
+		for i, player := range players {
+			err = uow.Tournaments().SetPlayerSeed(ctx, id, player.PlayerID, i+1)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Save bracket
+		return uow.Matches().CreateBracketMatches(ctx, matches)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Start tournament (includes business rules)
-	err = tournament.StartTournament()
+	return tournament, nil
+}
+
+// GenerateNextRound pairs the next round of a Swiss tournament once every
+// match of the current round has been completed. The tournament is locked
+// while the round is checked and created, so concurrent calls cannot pair
+// the same round twice.
+func (uc *TournamentUseCase) GenerateNextRound(ctx context.Context, id uuid.UUID) ([]*entities.Match, error) {
+	var next []*entities.Match
+	err := runInTransaction(ctx, uc.repoFactory, func(uow repositories.UnitOfWork) error {
+		tournament, err := uow.Tournaments().GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if tournament.Type != entities.TournamentTypeSwiss {
+			return entities.ErrRoundsNotSupported
+		}
+		if !tournament.IsInProgress() {
+			return entities.ErrTournamentNotInProgress
+		}
+
+		matches, err := uow.Matches().GetByTournamentID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, match := range matches {
+			if match.Status != entities.MatchStatusCompleted {
+				return entities.ErrRoundNotComplete
+			}
+		}
+
+		round := currentRound(matches)
+		if round >= tournament.SwissRounds {
+			return entities.ErrNoMoreRounds
+		}
+
+		players, err := uow.Tournaments().GetPlayers(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// Pair from the current standings
+		ranking := buildTable(seededPlayerIDs(players), matches, tournament.Tiebreakers)
+		next, err = buildSwissRound(id, round+1, pairSwissRound(ranking, matches))
+		if err != nil {
+			return err
+		}
+
+		return uow.Matches().CreateBracketMatches(ctx, next)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Save tournament changes
-	err = uc.tournamentRepo.Update(ctx, tournament)
+	return next, nil
+}
+
+// GetTournamentTable computes the round-robin table from completed matches;
+// group stage tournaments get one table per group
+func (uc *TournamentUseCase) GetTournamentTable(ctx context.Context, id uuid.UUID) ([]*TableRow, error) {
+	tournament, err := uc.tournamentRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate bracket (simplified)
-	err = uc.generateBracket(ctx, tournament)
+	players, err := uc.tournamentRepo.GetPlayers(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return tournament, nil
+	matches, err := uc.matchRepo.GetByTournamentID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if tournament.Type != entities.TournamentTypeGroupKnockout {
+		return buildTable(seededPlayerIDs(players), matches, tournament.Tiebreakers), nil
+	}
+
+	var table []*TableRow
+	members := groupMembers(matches)
+	for _, number := range groupNumbers(members) {
+		groupNumber := number
+		rows := buildTable(members[number], groupMatchesOf(matches, number), tournament.Tiebreakers)
+		for _, row := range rows {
+			row.Group = &groupNumber
+		}
+		table = append(table, rows...)
+	}
+	return table, nil
 }
 
-// generateBracket creates initial matches for the tournament (simplified)
-func (uc *TournamentUseCase) generateBracket(ctx context.Context, tournament *entities.Tournament) error {
-	// This is a simplified bracket generation
-	// In a real implementation, you'd have more complex logic for different tournament types
-	
-	// For now, just create placeholder matches
-	match1 := entities.NewMatch(tournament.ID, 1, 1)
-	match2 := entities.NewMatch(tournament.ID, 1, 2)
-	
-	// Save matches
-	err := uc.matchRepo.Create(ctx, match1)
-	if err != nil {
-		return err
+// generateMatches creates the matches the tournament format needs up front
+func generateMatches(tournament *entities.Tournament, players []uuid.UUID) ([]*entities.Match, error) {
+	switch tournament.Type {
+	case entities.TournamentTypeSingleElimination:
+		return buildSingleEliminationBracket(tournament.ID, players)
+	case entities.TournamentTypeDoubleElimination:
+		return buildDoubleEliminationBracket(tournament.ID, players)
+	case entities.TournamentTypeRoundRobin:
+		return buildRoundRobinSchedule(tournament.ID, players, 1), nil
+	case entities.TournamentTypeGroupKnockout:
+		if err := tournament.ResolveGroupCount(len(players)); err != nil {
+			return nil, err
+		}
+		return buildGroupStage(tournament.ID, players, tournament.GroupCount), nil
+	case entities.TournamentTypeSwiss:
+		// Only the first round is known up front; later rounds are paired
+		// from the standings by GenerateNextRound
+		if err := tournament.ResolveSwissRounds(len(players)); err != nil {
+			return nil, err
+		}
+		ranking := buildTable(players, nil, tournament.Tiebreakers)
+		return buildSwissRound(tournament.ID, 1, pairSwissRound(ranking, nil))
+	default:
+		return nil, entities.ErrInvalidTournamentType
 	}
-	
-	return uc.matchRepo.Create(ctx, match2)
-}
\ No newline at end of file
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"context"
+
+	"darts-league-backend/internal/domain/repositories"
+)
+
+// runInTransaction executes fn inside a unit of work, committing when it
+// succeeds and rolling back when it fails
+func runInTransaction(ctx context.Context, factory repositories.RepositoryFactory, fn func(uow repositories.UnitOfWork) error) error {
+	uow, err := factory.NewUnitOfWork()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			uow.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(uow); err != nil {
+		uow.Rollback(ctx)
+		return err
+	}
+	return uow.Commit(ctx)
+}
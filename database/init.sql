@@ -8,6 +8,7 @@ CREATE TABLE players (
     email VARCHAR(255) UNIQUE,
     avatar_url VARCHAR(500),
     nickname VARCHAR(50),
+    rating INTEGER, -- used to seed tournaments
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
@@ -25,6 +26,10 @@ CREATE TABLE leagues (
     points_for_runner_up INTEGER DEFAULT 2,
     points_for_semi_final INTEGER DEFAULT 1,
     max_players INTEGER,
+    points_schedule JSONB, -- points by finishing position ("1", "2") or elimination round ("winner", "semi_final", "last_16")
+    participation_points INTEGER DEFAULT 0,
+    points_per_match_win INTEGER DEFAULT 0,
+    standings_tiebreakers JSONB DEFAULT '["tournaments_won", "finals_reached", "head_to_head", "league_average"]', -- standings order after points
     
     -- Dates
     start_date DATE,
@@ -71,13 +76,18 @@ CREATE TABLE tournaments (
     description TEXT,
     
     -- Tournament format
-    type VARCHAR(50) NOT NULL, -- 'single_elimination', 'double_elimination', 'round_robin'
+    type VARCHAR(50) NOT NULL, -- 'single_elimination', 'double_elimination', 'round_robin', 'group_knockout', 'swiss'
     status VARCHAR(50) DEFAULT 'setup', -- 'setup', 'in_progress', 'completed'
     
     -- Game settings
-    game_type VARCHAR(50) DEFAULT '501', -- '501', '301', 'cricket'
+    game_type VARCHAR(50) DEFAULT '501', -- '501', '301', 'cricket', 'cricket_cut_throat', 'around_the_clock', 'shanghai', 'killer', 'bobs_27'
     legs_per_match INTEGER DEFAULT 3,
     sets_per_match INTEGER DEFAULT 1,
+    match_format VARCHAR(20) DEFAULT 'best_of', -- 'best_of', 'fixed' (every leg played, draws possible)
+    final_set_tiebreak BOOLEAN DEFAULT FALSE, -- deciding set (or legs-only match) must be won by two clear legs
+    start_score INTEGER DEFAULT 501, -- X01 starting score, e.g. 301, 501, 701, 1001
+    in_rule VARCHAR(20) DEFAULT 'straight', -- 'straight', 'double', 'master' (double or treble)
+    out_rule VARCHAR(20) DEFAULT 'double', -- 'straight', 'double', 'master'
     
     -- Tournament details
     max_players INTEGER,
@@ -89,7 +99,18 @@ CREATE TABLE tournaments (
     scheduled_date DATE,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     started_at TIMESTAMP,
-    completed_at TIMESTAMP
+    completed_at TIMESTAMP,
+
+    -- Format settings
+    grand_final_reset BOOLEAN DEFAULT TRUE, -- double elimination: replay the final if the losers-bracket player wins
+    tiebreakers JSONB DEFAULT '["leg_difference", "legs_won", "head_to_head"]', -- round-robin table order after points
+    group_count INTEGER DEFAULT 0, -- group stage: 0 = groups of four
+    qualifiers_per_group INTEGER DEFAULT 2,
+    swiss_rounds INTEGER DEFAULT 0, -- swiss: 0 = derived from the field size
+
+    -- Seeding, fixed when the tournament starts
+    seeding_method VARCHAR(20), -- 'registration', 'league_position', 'rating', 'manual', 'random'
+    seeding_random_seed BIGINT -- random draws: RNG seed, so the draw can be reproduced
 );
 
 -- Tournament participants (subset of league players)
@@ -114,10 +135,37 @@ CREATE TABLE matches (
     player1_score INTEGER DEFAULT 0, -- sets/legs won
     player2_score INTEGER DEFAULT 0,
     winner_id UUID REFERENCES players(id),
-    status VARCHAR(50) DEFAULT 'pending', -- 'pending', 'in_progress', 'completed'
+    status VARCHAR(50) DEFAULT 'pending', -- 'pending', 'ready', 'in_progress', 'completed'
+    is_bye BOOLEAN DEFAULT FALSE, -- single participant, winner advanced automatically
     started_at TIMESTAMP,
     completed_at TIMESTAMP,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    -- Bracket progression
+    bracket_side VARCHAR(20) DEFAULT 'winners', -- 'winners', 'losers', 'grand_final', 'group'
+    group_number INTEGER, -- group stage matches only
+    next_match_id UUID REFERENCES matches(id) ON DELETE SET NULL, -- match the winner advances to
+    next_match_slot INTEGER, -- 1 = player1, 2 = player2
+    loser_next_match_id UUID REFERENCES matches(id) ON DELETE SET NULL, -- double elimination drop-down
+    loser_next_match_slot INTEGER,
+
+    -- How a completed match was decided
+    outcome VARCHAR(20), -- 'played', 'walkover', 'forfeit', 'retired'; only played matches count in statistics
+    outcome_reason TEXT,
+
+    game_type VARCHAR(50), -- standalone matches only; tournament matches play the tournament's game
+
+    -- Sets and legs
+    player1_legs INTEGER DEFAULT 0, -- legs won in the current set
+    player2_legs INTEGER DEFAULT 0,
+    throw_off_method VARCHAR(20), -- 'bull_off', 'coin_toss'
+    throw_off_winner_id UUID REFERENCES players(id), -- throws first in the first leg
+
+    -- Handicaps for mixed-ability matches
+    player1_handicap_start INTEGER DEFAULT 0, -- 0 starts on the game's usual score
+    player1_handicap_legs INTEGER DEFAULT 0, -- legs awarded before the first leg
+    player2_handicap_start INTEGER DEFAULT 0,
+    player2_handicap_legs INTEGER DEFAULT 0
 );
 
 -- Games table (individual legs within a match)
@@ -126,12 +174,19 @@ CREATE TABLE games (
     match_id UUID REFERENCES matches(id) ON DELETE CASCADE,
     leg_number INTEGER NOT NULL,
     set_number INTEGER NOT NULL DEFAULT 1,
-    player1_score INTEGER DEFAULT 501, -- remaining score
-    player2_score INTEGER DEFAULT 501,
+    first_thrower_id UUID REFERENCES players(id), -- player who threw first in the leg
+    player1_score INTEGER DEFAULT 0, -- remaining score in X01, otherwise points, targets hit or lives
+    player2_score INTEGER DEFAULT 0,
     winner_id UUID REFERENCES players(id),
     status VARCHAR(50) DEFAULT 'in_progress', -- 'in_progress', 'completed'
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    completed_at TIMESTAMP
+    completed_at TIMESTAMP,
+    player1_marks JSONB, -- cricket marks per target, e.g. {"20": 3, "25": 1}
+    player2_marks JSONB,
+    player1_number INTEGER, -- killer legs: each player's number
+    player2_number INTEGER,
+    player1_killer BOOLEAN DEFAULT FALSE, -- killer legs: has hit their own double
+    player2_killer BOOLEAN DEFAULT FALSE
 );
 
 -- Individual throws/turns
@@ -141,11 +196,30 @@ CREATE TABLE throws (
     player_id UUID REFERENCES players(id),
     throw_number INTEGER NOT NULL, -- 1, 2, 3 within the turn
     turn_number INTEGER NOT NULL, -- which turn in the leg
+    segment INTEGER NOT NULL DEFAULT 0, -- 1-20, 25 for the bull, 0 for a miss
     score INTEGER NOT NULL, -- points scored in this throw
     multiplier INTEGER DEFAULT 1, -- 1=single, 2=double, 3=triple
     is_bust BOOLEAN DEFAULT FALSE,
     remaining_score INTEGER, -- score after this throw
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    is_visit BOOLEAN DEFAULT FALSE, -- a whole visit entered as its total
+    darts INTEGER NOT NULL DEFAULT 1 -- darts the row stands for: 1, or up to 3 for a visit
+);
+
+-- Append-only scoring log per leg; throws and leg scores are rebuilt from it
+CREATE TABLE scoring_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    game_id UUID REFERENCES games(id) ON DELETE CASCADE,
+    sequence INTEGER NOT NULL, -- position in the leg's log, from 1
+    type VARCHAR(20) NOT NULL, -- 'darts', 'visit', 'complete_leg', 'undo_dart', 'undo_visit', 'edit_visit'
+    darts JSONB, -- [{"segment": 20, "multiplier": 3}, ...]
+    visit JSONB, -- visit total, e.g. {"total": 100} or {"total": 40, "darts": 1}
+    turn_number INTEGER, -- visit replaced by an 'edit_visit'
+    winner_id UUID REFERENCES players(id), -- winner entered by a 'complete_leg'
+    recorded_by VARCHAR(100), -- scorer who entered the event
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(game_id, sequence)
 );
 
 -- Tournament statistics (per tournament)
@@ -173,6 +247,11 @@ CREATE TABLE tournament_stats (
     singles_hit INTEGER DEFAULT 0,
     doubles_hit INTEGER DEFAULT 0,
     triples_hit INTEGER DEFAULT 0,
+    one_eighties INTEGER DEFAULT 0,
+    
+    -- Checkout counts, added up into the league statistics
+    checkout_attempts INTEGER DEFAULT 0,
+    checkout_hits INTEGER DEFAULT 0,
     
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     UNIQUE(tournament_id, player_id)
@@ -196,6 +275,9 @@ CREATE TABLE league_stats (
     overall_average DECIMAL(5,2) DEFAULT 0,
     best_finish INTEGER,
     overall_checkout_percentage DECIMAL(5,2) DEFAULT 0,
+    one_eighties INTEGER DEFAULT 0,
+    checkout_attempts INTEGER DEFAULT 0,
+    checkout_hits INTEGER DEFAULT 0,
     
     -- League specific achievements
     tournament_wins INTEGER DEFAULT 0,
@@ -216,6 +298,7 @@ CREATE INDEX idx_tournament_players_tournament ON tournament_players(tournament_
 CREATE INDEX idx_tournament_players_player ON tournament_players(player_id);
 CREATE INDEX idx_matches_tournament ON matches(tournament_id);
 CREATE INDEX idx_matches_status ON matches(status);
+CREATE INDEX idx_matches_next_match ON matches(next_match_id);
 CREATE INDEX idx_games_match ON games(match_id);
 CREATE INDEX idx_throws_game ON throws(game_id);
 CREATE INDEX idx_throws_player ON throws(player_id);
@@ -17,7 +17,7 @@ export const useMatchesStore = defineStore('matches', {
       return state.matches.filter(match => match.status === 'in_progress')
     },
     pendingMatches: (state) => {
-      return state.matches.filter(match => match.status === 'pending')
+      return state.matches.filter(match => ['pending', 'ready'].includes(match.status))
     },
     completedMatches: (state) => {
       return state.matches.filter(match => match.status === 'completed')